@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+
+variable "pr_number" {
+  type = string
+}
+
+# A short-lived service for a CI preview, expiring 3 days after it is created.
+resource "timescale_service" "preview" {
+  name = "preview-${var.pr_number}"
+  ttl  = "72h"
+  tags = {
+    purpose = "ci-preview"
+  }
+}
+
+# Deletes the expired CI preview services left behind by pipelines that did
+# not destroy them. Invoke it on demand or from a scheduled job (Terraform 1.14+):
+#   terraform apply -invoke=action.timescale_delete_expired_services.previews
+action "timescale_delete_expired_services" "previews" {
+  config {
+    tags = {
+      purpose = "ci-preview"
+    }
+  }
+}
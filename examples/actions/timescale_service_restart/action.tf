@@ -0,0 +1,68 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "timescale_log_exporter" "cloudwatch" {
+  name   = "cloudwatch-logs"
+  region = "us-east-1"
+
+  cloudwatch = {
+    region          = "us-east-1"
+    role_arn        = "arn:aws:iam::123456789012:role/MyLogsExporterRole"
+    log_group_name  = "/myapplication/logs"
+    log_stream_name = "exporter-stream"
+  }
+}
+
+resource "timescale_service" "test" {
+  name            = "restart-test"
+  milli_cpu       = 1000
+  memory_gb       = 4
+  region_code     = "us-east-1"
+  log_exporter_id = timescale_log_exporter.cloudwatch.id
+
+  # Restart whenever the log exporter attachment changes (Terraform 1.14+).
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.timescale_service_restart.test]
+    }
+  }
+}
+
+action "timescale_service_restart" "test" {
+  config {
+    service_id = timescale_service.test.id
+    timeout    = "30m"
+  }
+}
+
+# The action can also be invoked on demand:
+#   terraform apply -invoke=action.timescale_service_restart.test
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "timescale_service" "test" {
+  name        = "switchover-test"
+  milli_cpu   = 1000
+  memory_gb   = 4
+  region_code = "us-east-1"
+  ha_replicas = 1
+}
+
+action "timescale_service_switchover" "test" {
+  config {
+    service_id = timescale_service.test.id
+  }
+}
+
+# Trigger a switchover to test failover (Terraform 1.14+):
+#   terraform apply -invoke=action.timescale_service_switchover.test
+
+output "primary_node" {
+  value = timescale_service.test.primary_node
+}
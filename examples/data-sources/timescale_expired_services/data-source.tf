@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+
+# Expired CI preview services
+data "timescale_expired_services" "previews" {
+  tags = {
+    purpose = "ci-preview"
+  }
+}
+
+output "expired_previews" {
+  value = [for s in data.timescale_expired_services.previews.services : "${s.name} (${s.expires_at})"]
+}
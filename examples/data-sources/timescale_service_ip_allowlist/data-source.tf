@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+data "timescale_service_ip_allowlist" "test" {
+  service_id = var.service_id
+}
+
+variable "service_id" {
+  type = string
+}
+
+output "allowed_cidr_blocks" {
+  value = data.timescale_service_ip_allowlist.test.cidr_blocks
+}
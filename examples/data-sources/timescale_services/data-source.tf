@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+# All services owned by the analytics team
+data "timescale_services" "analytics" {
+  tags = {
+    team = "analytics"
+  }
+}
+
+output "analytics_service_ids" {
+  value = data.timescale_services.analytics.services[*].id
+}
@@ -0,0 +1,62 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+    postgresql = {
+      source = "cyrilgdn/postgresql"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+
+variable "db_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "timescale_service" "test" {
+  name                = "app-db"
+  milli_cpu           = 1000
+  memory_gb           = 4
+  region_code         = "us-east-1"
+  password_wo         = var.db_password
+  password_wo_version = 1
+}
+
+# Connection details of the service, built from its endpoints and the password
+# passed in, never stored in plan or state.
+ephemeral "timescale_service_credentials" "app" {
+  service_id = timescale_service.test.id
+  password   = var.db_password
+}
+
+# Pass the connection details to a provider that manages objects inside the database.
+provider "postgresql" {
+  host     = ephemeral.timescale_service_credentials.app.hostname
+  port     = ephemeral.timescale_service_credentials.app.port
+  database = ephemeral.timescale_service_credentials.app.database
+  username = ephemeral.timescale_service_credentials.app.username
+  password = var.db_password
+  sslmode  = "require"
+}
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+
+# A 48 character password, generated on every run and never stored in state.
+ephemeral "timescale_service_password" "app" {
+  length = 48
+}
+
+resource "timescale_service_password_rotation" "app" {
+  service_id    = var.service_id
+  rotation_days = 90
+  password_wo   = ephemeral.timescale_service_password.app.value
+}
+
+variable "service_id" {
+  type = string
+}
@@ -24,4 +24,16 @@ provider "timescale" {
   access_key = var.ts_access_key
   secret_key = var.ts_secret_key
   project_id = var.ts_project_id
+
+  # Optional: tags applied to every taggable resource.
+  default_tags = {
+    managed_by = "terraform"
+  }
+
+  # Optional: fail plans that raise the projected monthly compute cost above
+  # these limits.
+  max_monthly_cost    = 5000
+  environment_budgets = {
+    DEV = 200
+  }
 }
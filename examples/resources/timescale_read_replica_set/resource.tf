@@ -0,0 +1,58 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "timescale_service" "primary" {
+  name        = "primary"
+  milli_cpu   = 1000
+  memory_gb   = 4
+  region_code = "us-east-1"
+}
+
+resource "timescale_read_replica_set" "analytics" {
+  primary_service_id        = timescale_service.primary.id
+  name                      = "analytics-replicas"
+  nodes                     = 2
+  milli_cpu                 = 2000
+  memory_gb                 = 8
+  connection_pooler_enabled = true
+}
+
+output "replica_node_endpoints" {
+  value = timescale_read_replica_set.analytics.endpoints
+}
+
+# A read replica created with timescale_service.read_replica_source can be
+# moved to this resource without recreating it (Terraform 1.8+):
+#
+# moved {
+#   from = timescale_service.replica
+#   to   = timescale_read_replica_set.replica
+# }
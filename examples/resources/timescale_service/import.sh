@@ -0,0 +1,8 @@
+# Import a service by its ID
+terraform import timescale_service.example aaaaa11111
+
+# Import a service by project ID and service ID
+terraform import timescale_service.example bbbbb22222/aaaaa11111
+
+# Import a service by its name, which must be unique in the project
+terraform import timescale_service.example name:my-service
@@ -34,7 +34,30 @@ resource "timescale_service" "test" {
   region_code = "us-east-1"
 }
 
+# Service sized with a compute preset instead of milli_cpu and memory_gb.
+# The size is checked against the plans offered in the region at plan time.
+resource "timescale_service" "preset" {
+  name         = "preset"
+  compute_size = "2cpu-8gb"
+  region_code  = "us-east-1"
+}
+
+# Estimated compute cost, from the plan price of the region and size times the
+# node count. It is known at plan time, so cost changes show up in plans.
+output "preset_monthly_cost" {
+  value = timescale_service.preset.estimated_monthly_cost
+}
+
+# Short-lived service, for example for a CI preview. It is tagged with its
+# expiry, plans warn once it has passed, and expired services can be deleted
+# with the timescale_delete_expired_services action.
+resource "timescale_service" "preview" {
+  name = "preview"
+  ttl  = "72h"
+}
+
 # Read replica (single node, default)
+# Deprecated: use the timescale_read_replica_set resource for new read replicas.
 resource "timescale_service" "read_replica" {
   read_replica_source = timescale_service.test.id
 }
@@ -46,6 +69,17 @@ resource "timescale_service" "read_replica_multi" {
   read_replica_nodes  = 3
 }
 
+# Read replica promoted to an independent primary, e.g. for disaster recovery.
+# Set promote on an existing replica, together with the password the promoted
+# service uses from then on; read_replica_source can be kept or removed.
+resource "timescale_service" "promoted_replica" {
+  name                = "promoted-replica"
+  read_replica_source = timescale_service.test.id
+  promote             = true
+  password_wo         = var.db_password
+  password_wo_version = 1
+}
+
 # Service with write-only password (Terraform 1.11+)
 # The password is sent to the API but never stored in Terraform state.
 # Increment password_wo_version to trigger a password change.
@@ -62,3 +96,79 @@ resource "timescale_service" "secure" {
   password_wo         = var.db_password
   password_wo_version = 1
 }
+
+# Tagged service. Tags are merged with the provider default_tags and can be
+# used to look services up with the timescale_services data source.
+resource "timescale_service" "tagged" {
+  name        = "tagged-service"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+
+  tags = {
+    team        = "analytics"
+    cost_center = "cc-1234"
+    owner       = "data-platform"
+  }
+}
+
+# Restart the service when restart-requiring settings change, and after
+# attaching a log exporter so that the attachment completes.
+variable "max_connections" {
+  type    = string
+  default = "200"
+}
+
+resource "timescale_service" "restartable" {
+  name                              = "restartable-service"
+  milli_cpu                         = 500
+  memory_gb                         = 2
+  region_code                       = "us-east-1"
+  restart_after_log_exporter_attach = true
+
+  restart_on_change = {
+    max_connections = var.max_connections
+  }
+}
+
+# Autoscaled service. The platform resizes it between the bounds as its CPU
+# utilization changes; milli_cpu and memory_gb are the size it starts at and
+# current_milli_cpu/current_memory_gb report the live size.
+resource "timescale_service" "autoscaled" {
+  name        = "autoscaled-service"
+  milli_cpu   = 1000
+  memory_gb   = 4
+  region_code = "us-east-1"
+
+  autoscaling = {
+    min_milli_cpu        = 500
+    min_memory_gb        = 2
+    max_milli_cpu        = 4000
+    max_memory_gb        = 16
+    scale_up_threshold   = 75
+    scale_down_threshold = 25
+  }
+}
+
+# Service with a tuned connection pooler. Settings that are left out keep
+# their current value.
+resource "timescale_service" "pooled" {
+  name                      = "pooled-service"
+  milli_cpu                 = 1000
+  memory_gb                 = 4
+  region_code               = "us-east-1"
+  connection_pooler_enabled = true
+
+  connection_pooler = {
+    pool_mode              = "transaction"
+    default_pool_size      = 20
+    max_client_connections = 2000
+
+    databases = {
+      reporting = {
+        pool_mode = "session"
+        pool_size = 10
+      }
+    }
+  }
+}
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "timescale_service" "test" {
+  name        = "allowlist-test"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+}
+
+resource "timescale_service_ip_allowlist" "test" {
+  service_id = timescale_service.test.id
+
+  cidr_blocks = [
+    "203.0.113.0/24",
+    "198.51.100.7/32",
+  ]
+}
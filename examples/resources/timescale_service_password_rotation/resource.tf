@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+provider "timescale" {
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+  project_id = var.ts_project_id
+}
+
+
+resource "timescale_service" "test" {
+  name        = "app-db"
+  milli_cpu   = 1000
+  memory_gb   = 4
+  region_code = "us-east-1"
+}
+
+# A new password is generated on every run, it is only applied on rotation.
+ephemeral "timescale_service_password" "app" {}
+
+# Rotate the password every 30 days.
+resource "timescale_service_password_rotation" "app" {
+  service_id    = timescale_service.test.id
+  rotation_days = 30
+  password_wo   = ephemeral.timescale_service_password.app.value
+}
+
+# Store each rotated password for the applications, in the same apply.
+resource "aws_secretsmanager_secret" "app_db" {
+  name = "app-db-password"
+}
+
+resource "aws_secretsmanager_secret_version" "app_db" {
+  secret_id                = aws_secretsmanager_secret.app_db.id
+  secret_string_wo         = ephemeral.timescale_service_password.app.value
+  secret_string_wo_version = timescale_service_password_rotation.app.version
+}
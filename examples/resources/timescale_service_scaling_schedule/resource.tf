@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+# Ignore the compute size so the service does not undo the schedule.
+resource "timescale_service" "warehouse" {
+  name        = "warehouse"
+  milli_cpu   = 1000
+  memory_gb   = 4
+  region_code = "us-east-1"
+
+  lifecycle {
+    ignore_changes = [milli_cpu, memory_gb]
+  }
+}
+
+# Scaled up for the nightly batch window and back down afterwards. Run
+# `terraform apply` periodically, e.g. hourly from CI, so the schedule is enforced.
+resource "timescale_service_scaling_schedule" "warehouse" {
+  service_id = timescale_service.warehouse.id
+  timezone   = "Europe/Madrid"
+  milli_cpu  = 1000
+  memory_gb  = 4
+
+  windows = [
+    {
+      start_at  = "0 1 * * *"
+      end_at    = "0 5 * * *"
+      milli_cpu = 4000
+      memory_gb = 16
+    },
+  ]
+}
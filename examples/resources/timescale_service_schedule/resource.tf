@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    timescale = {
+      source  = "timescale/timescale"
+      version = "~> 2.13"
+    }
+  }
+}
+
+# Authenticate using client credentials.
+# They are issued through the Timescale UI.
+# When required, they will exchange for a short-lived JWT to do the calls.
+provider "timescale" {
+  project_id = var.ts_project_id
+  access_key = var.ts_access_key
+  secret_key = var.ts_secret_key
+}
+
+variable "ts_project_id" {
+  type = string
+}
+
+variable "ts_access_key" {
+  type = string
+}
+
+variable "ts_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+# Leave `paused` unset so the service follows the schedule.
+resource "timescale_service" "dev" {
+  name        = "dev"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+  tags = {
+    environment = "DEV"
+  }
+}
+
+# Paused on weekday nights and over the weekend. Run `terraform apply`
+# periodically, e.g. hourly from CI, so the schedule is enforced.
+resource "timescale_service_schedule" "dev" {
+  service_id = timescale_service.dev.id
+  timezone   = "Europe/Madrid"
+
+  # Friday's pause lasts until Monday's resume.
+  pause_windows = [
+    {
+      pause_at  = "0 20 * * MON-FRI"
+      resume_at = "0 8 * * MON-FRI"
+    },
+  ]
+}
@@ -30,10 +30,14 @@ var (
 	ToggleServiceMutation string
 	//go:embed queries/toggle_connection_pooler.graphql
 	ToggleConnectionPoolerMutation string
+	//go:embed queries/set_connection_pooler_settings.graphql
+	SetConnectionPoolerSettingsMutation string
 	//go:embed queries/toggle_data_tiering.graphql
 	ToggleDataTieringMutation string
 	//go:embed queries/set_env_tag.graphql
 	SetEnvironmentTagMutation string
+	//go:embed queries/set_service_tags.graphql
+	SetServiceTagsMutation string
 	//go:embed queries/get_all_services.graphql
 	GetAllServicesQuery string
 	//go:embed queries/get_service.graphql
@@ -46,6 +50,28 @@ var (
 	SetReplicaCountMutation string
 	//go:embed queries/change_service_password.graphql
 	ResetServicePassword string
+	//go:embed queries/upgrade_service.graphql
+	UpgradeServiceMutation string
+	//go:embed queries/set_maintenance_window.graphql
+	SetMaintenanceWindowMutation string
+	//go:embed queries/restart_service.graphql
+	RestartServiceMutation string
+	//go:embed queries/promote_read_replica.graphql
+	PromoteReadReplicaMutation string
+	//go:embed queries/switchover_service.graphql
+	SwitchoverServiceMutation string
+	//go:embed queries/set_service_autoscaling.graphql
+	SetServiceAutoscalingMutation string
+	//go:embed queries/get_service_parameters.graphql
+	GetServiceParametersQuery string
+	//go:embed queries/set_service_parameters.graphql
+	SetServiceParametersMutation string
+	//go:embed queries/reset_service_parameters.graphql
+	ResetServiceParametersMutation string
+	//go:embed queries/get_service_ip_allowlist.graphql
+	GetServiceIPAllowlistQuery string
+	//go:embed queries/set_service_ip_allowlist.graphql
+	SetServiceIPAllowlistMutation string
 
 	// VCPs
 	//go:embed queries/vpcs.graphql
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,10 +23,13 @@ type Service struct {
 	Resources           []ResourceSpec       `json:"resources"`
 	Created             string               `json:"created"`
 	ReplicaStatus       string               `json:"replicaStatus"`
+	PrimaryNode         string               `json:"primaryNode"`
 	VPCEndpoint         *VPCEndpoint         `json:"vpcEndpoint"`
 	ForkSpec            *ForkSpec            `json:"forkedFromId"`
 	Metadata            *Metadata            `json:"metadata"`
 	DataTieringSettings *DataTieringSettings `json:"dataTieringSettings"`
+	MaintenanceWindow   *MaintenanceWindow   `json:"maintenanceWindow"`
+	Autoscaling         *AutoscalingSettings `json:"autoscalingSettings"`
 
 	// Endpoints contains the all service endpoints
 	Endpoints *ServiceEndpoints `json:"endpoints,omitempty"`
@@ -38,8 +42,31 @@ type ServiceSpec struct {
 	PoolerHostname     string  `json:"poolerHostName"`
 	PoolerPort         int64   `json:"poolerPort"`
 	PoolerEnabled      bool    `json:"connectionPoolerEnabled"`
+	DefaultDBName      string  `json:"defaultDBName"`
 	MetricExporterUUID *string `json:"metricExporterUuid"`
 	GenericExporterID  *string `json:"genericExporterID"`
+	PgVersion          string  `json:"pgVersion"`
+	TimescaleDBVersion string  `json:"timescaledbVersion"`
+
+	PoolerSettings *PoolerSettings `json:"poolerSettings"`
+}
+
+// PoolerSettings configures the connection pooler of a service. Zero values
+// are omitted when updating, which leaves the current setting unchanged.
+// Databases replaces all per-database overrides.
+type PoolerSettings struct {
+	PoolMode             string                   `json:"poolMode,omitempty"`
+	DefaultPoolSize      int64                    `json:"defaultPoolSize,omitempty"`
+	MaxClientConnections int64                    `json:"maxClientConnections,omitempty"`
+	Databases            []PoolerDatabaseSettings `json:"databases"`
+}
+
+// PoolerDatabaseSettings overrides the pool settings for one database. Zero
+// values inherit the service-wide setting.
+type PoolerDatabaseSettings struct {
+	Name     string `json:"name"`
+	PoolMode string `json:"poolMode,omitempty"`
+	PoolSize int64  `json:"poolSize,omitempty"`
 }
 
 type VPCEndpoint struct {
@@ -60,7 +87,33 @@ type ResourceSpec struct {
 }
 
 type Metadata struct {
-	Environment string `json:"environment"`
+	Environment string       `json:"environment"`
+	Tags        []ServiceTag `json:"tags"`
+}
+
+// ServiceTag is a free-form key/value pair attached to a service.
+type ServiceTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TagMap returns the service tags as a map.
+func (m *Metadata) TagMap() map[string]string {
+	tags := make(map[string]string, len(m.Tags))
+	for _, t := range m.Tags {
+		tags[t.Key] = t.Value
+	}
+	return tags
+}
+
+// tagList converts a tag map into the list the API expects, sorted by key.
+func tagList(tags map[string]string) []ServiceTag {
+	list := make([]ServiceTag, 0, len(tags))
+	for k, v := range tags {
+		list = append(list, ServiceTag{Key: k, Value: v})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+	return list
 }
 
 // DataTieringSettings reflects dataTieringSettings on the Service object.
@@ -69,6 +122,26 @@ type DataTieringSettings struct {
 	Enabled bool `json:"enabled"`
 }
 
+// MaintenanceWindow is the weekly window, in UTC, during which the platform
+// may apply maintenance to the service.
+type MaintenanceWindow struct {
+	DayOfWeek     string `json:"dayOfWeek"`
+	StartHour     int64  `json:"startHour"`
+	DurationHours int64  `json:"durationHours"`
+}
+
+// AutoscalingSettings are the compute bounds and CPU utilization thresholds,
+// in percent, between which the platform resizes the service.
+type AutoscalingSettings struct {
+	Enabled            bool  `json:"enabled"`
+	MinMilliCPU        int64 `json:"minMilliCPU"`
+	MaxMilliCPU        int64 `json:"maxMilliCPU"`
+	MinMemoryGB        int64 `json:"minMemoryGB"`
+	MaxMemoryGB        int64 `json:"maxMemoryGB"`
+	ScaleUpThreshold   int64 `json:"scaleUpThreshold"`
+	ScaleDownThreshold int64 `json:"scaleDownThreshold"`
+}
+
 type CreateServiceRequest struct {
 	Name     string
 	MilliCPU string
@@ -84,6 +157,10 @@ type CreateServiceRequest struct {
 
 	EnableConnectionPooler bool
 	EnvironmentTag         string
+	// PgVersion pins the Postgres major version of the new service. The
+	// platform default is used when empty.
+	PgVersion string
+	Tags      map[string]string
 }
 
 type ForkConfig struct {
@@ -128,6 +205,15 @@ type ServiceEndpoints struct {
 	Primary *EndpointAddress `json:"primary"`
 	Replica *EndpointAddress `json:"replica"`
 	Pooler  *EndpointAddress `json:"pooler"`
+	// Nodes lists the endpoint of every node, e.g. each read replica node.
+	Nodes []NodeEndpoint `json:"nodes"`
+}
+
+// NodeEndpoint is the endpoint of a single node of a service.
+type NodeEndpoint struct {
+	Name string `json:"name"`
+	Host string `json:"host"`
+	Port int    `json:"port"`
 }
 
 // EndpointAddress represents the endpoint address.
@@ -174,6 +260,12 @@ func (c *Client) CreateService(ctx context.Context, request CreateServiceRequest
 	if request.EnvironmentTag != "" {
 		variables["environmentTag"] = request.EnvironmentTag
 	}
+	if request.PgVersion != "" {
+		variables["pgVersion"] = request.PgVersion
+	}
+	if len(request.Tags) > 0 {
+		variables["tags"] = tagList(request.Tags)
+	}
 	req := map[string]interface{}{
 		"operationName": "CreateService",
 		"query":         CreateServiceMutation,
@@ -479,3 +571,228 @@ func (c *Client) SetEnvironmentTag(ctx context.Context, serviceID, environment s
 	}
 	return nil
 }
+
+// RestartService restarts the database of a service. The service goes
+// through a short unavailability and is READY again once it returns.
+func (c *Client) RestartService(ctx context.Context, serviceID string) error {
+	tflog.Trace(ctx, "Client.RestartService")
+	req := map[string]interface{}{
+		"operationName": "RestartService",
+		"query":         RestartServiceMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return ErrServiceNotFound
+		}
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// PromoteReadReplica detaches a read replica from its source and turns it
+// into an independent primary. The promotion cannot be undone.
+func (c *Client) PromoteReadReplica(ctx context.Context, serviceID string) error {
+	tflog.Trace(ctx, "Client.PromoteReadReplica")
+	req := map[string]interface{}{
+		"operationName": "PromoteReadReplica",
+		"query":         PromoteReadReplicaMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return ErrServiceNotFound
+		}
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// SwitchoverService makes an HA replica of the service the new primary. The
+// service briefly drops connections and is READY again once it completes.
+func (c *Client) SwitchoverService(ctx context.Context, serviceID string) error {
+	tflog.Trace(ctx, "Client.SwitchoverService")
+	req := map[string]interface{}{
+		"operationName": "SwitchoverService",
+		"query":         SwitchoverServiceMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return ErrServiceNotFound
+		}
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// SetServiceTags replaces all the tags of a service.
+func (c *Client) SetServiceTags(ctx context.Context, serviceID string, tags map[string]string) error {
+	tflog.Trace(ctx, "Client.SetServiceTags")
+	req := map[string]interface{}{
+		"operationName": "SetServiceTags",
+		"query":         SetServiceTagsMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+			"tags":      tagList(tags),
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// UpgradeServiceRequest describes an in-place version upgrade. Empty fields
+// are left unchanged.
+type UpgradeServiceRequest struct {
+	PgVersion          string
+	TimescaleDBVersion string
+}
+
+func (c *Client) UpgradeService(ctx context.Context, serviceID string, request UpgradeServiceRequest) error {
+	tflog.Trace(ctx, "Client.UpgradeService")
+	variables := map[string]any{
+		"projectId": c.projectID,
+		"serviceId": serviceID,
+	}
+	if request.PgVersion != "" {
+		variables["pgVersion"] = request.PgVersion
+	}
+	if request.TimescaleDBVersion != "" {
+		variables["timescaledbVersion"] = request.TimescaleDBVersion
+	}
+	req := map[string]interface{}{
+		"operationName": "UpgradeService",
+		"query":         UpgradeServiceMutation,
+		"variables":     variables,
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+func (c *Client) SetConnectionPoolerSettings(ctx context.Context, serviceID string, settings PoolerSettings) error {
+	tflog.Trace(ctx, "Client.SetConnectionPoolerSettings")
+	if settings.Databases == nil {
+		settings.Databases = []PoolerDatabaseSettings{}
+	}
+	req := map[string]interface{}{
+		"operationName": "SetConnectionPoolerSettings",
+		"query":         SetConnectionPoolerSettingsMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+			"settings":  settings,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// SetServiceAutoscaling enables autoscaling with the given settings, or
+// disables it when settings.Enabled is false.
+func (c *Client) SetServiceAutoscaling(ctx context.Context, serviceID string, settings AutoscalingSettings) error {
+	tflog.Trace(ctx, "Client.SetServiceAutoscaling")
+	req := map[string]interface{}{
+		"operationName": "SetServiceAutoscaling",
+		"query":         SetServiceAutoscalingMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+			"config":    settings,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+func (c *Client) SetMaintenanceWindow(ctx context.Context, serviceID string, window MaintenanceWindow) error {
+	tflog.Trace(ctx, "Client.SetMaintenanceWindow")
+	req := map[string]interface{}{
+		"operationName": "SetMaintenanceWindow",
+		"query":         SetMaintenanceWindowMutation,
+		"variables": map[string]any{
+			"projectId":     c.projectID,
+			"serviceId":     serviceID,
+			"dayOfWeek":     window.DayOfWeek,
+			"startHour":     window.StartHour,
+			"durationHours": window.DurationHours,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type GetServiceIPAllowlistResponse struct {
+	CIDRBlocks []string `json:"getServiceIpAllowlist"`
+}
+
+// GetServiceIPAllowlist returns the CIDR blocks allowed to reach the public endpoint
+// of a service. An empty list means the endpoint is reachable from anywhere.
+func (c *Client) GetServiceIPAllowlist(ctx context.Context, serviceID string) ([]string, error) {
+	tflog.Trace(ctx, "Client.GetServiceIPAllowlist")
+	req := map[string]interface{}{
+		"operationName": "GetServiceIpAllowlist",
+		"query":         GetServiceIPAllowlistQuery,
+		"variables": map[string]string{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+		},
+	}
+	var resp Response[GetServiceIPAllowlistResponse]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return nil, ErrServiceNotFound
+		}
+		return nil, resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no response found")
+	}
+	return resp.Data.CIDRBlocks, nil
+}
+
+// SetServiceIPAllowlist replaces the allowlist of a service. Passing an empty
+// list removes the restriction.
+func (c *Client) SetServiceIPAllowlist(ctx context.Context, serviceID string, cidrBlocks []string) error {
+	tflog.Trace(ctx, "Client.SetServiceIPAllowlist")
+	if cidrBlocks == nil {
+		cidrBlocks = []string{}
+	}
+	req := map[string]interface{}{
+		"operationName": "SetServiceIpAllowlist",
+		"query":         SetServiceIPAllowlistMutation,
+		"variables": map[string]any{
+			"projectId":  c.projectID,
+			"serviceId":  serviceID,
+			"cidrBlocks": cidrBlocks,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return ErrServiceNotFound
+		}
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
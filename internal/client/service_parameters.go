@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServiceParameter is a Postgres server configuration parameter (GUC) of a service.
+type ServiceParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// IsDefault is true when the parameter has not been changed from the platform default.
+	IsDefault bool `json:"isDefault"`
+}
+
+type GetServiceParametersResponse struct {
+	Parameters []*ServiceParameter `json:"getServiceParameters"`
+}
+
+func (c *Client) GetServiceParameters(ctx context.Context, serviceID string) ([]*ServiceParameter, error) {
+	tflog.Trace(ctx, "Client.GetServiceParameters")
+	req := map[string]interface{}{
+		"operationName": "GetServiceParameters",
+		"query":         GetServiceParametersQuery,
+		"variables": map[string]string{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+		},
+	}
+	var resp Response[GetServiceParametersResponse]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return nil, ErrServiceNotFound
+		}
+		return nil, resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no response found")
+	}
+	return resp.Data.Parameters, nil
+}
+
+// SetServiceParameters sets the given parameters. The platform reloads the
+// configuration, parameters that require a restart only take effect after a
+// RestartService.
+func (c *Client) SetServiceParameters(ctx context.Context, serviceID string, parameters map[string]string) error {
+	tflog.Trace(ctx, "Client.SetServiceParameters")
+	input := make([]map[string]string, 0, len(parameters))
+	for name, value := range parameters {
+		input = append(input, map[string]string{"name": name, "value": value})
+	}
+	req := map[string]interface{}{
+		"operationName": "SetServiceParameters",
+		"query":         SetServiceParametersMutation,
+		"variables": map[string]any{
+			"projectId":  c.projectID,
+			"serviceId":  serviceID,
+			"parameters": input,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
+
+// ResetServiceParameters restores the given parameters to their platform defaults.
+func (c *Client) ResetServiceParameters(ctx context.Context, serviceID string, names []string) error {
+	tflog.Trace(ctx, "Client.ResetServiceParameters")
+	req := map[string]interface{}{
+		"operationName": "ResetServiceParameters",
+		"query":         ResetServiceParametersMutation,
+		"variables": map[string]any{
+			"projectId": c.projectID,
+			"serviceId": serviceID,
+			"names":     names,
+		},
+	}
+	var resp Response[any]
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		if resp.Errors[0].Message == ErrServiceNotFound.Error() {
+			return ErrServiceNotFound
+		}
+		return resp.Errors[0]
+	}
+	if resp.Data == nil {
+		return errors.New("no response found")
+	}
+	return nil
+}
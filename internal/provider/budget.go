@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+const (
+	errBudgetExceeded    = "Monthly Budget Exceeded"
+	errBudgetNotChecked  = "Monthly Budget Not Checked"
+	errMaxMonthlyCost    = "The projected monthly compute cost of the project would be $%.2f, above the max_monthly_cost of $%.2f. %s"
+	errEnvironmentBudget = "The projected monthly compute cost of the %s environment would be $%.2f, above its budget of $%.2f in environment_budgets. %s"
+)
+
+// costBudget holds the provider-level spending limits. Plans of services and
+// read replica sets are checked against it.
+//
+// The services of the project are listed once per provider run, and the
+// compute planned for each resource is kept, so every plan is checked against
+// the sum of the plans made before it in the same run.
+type costBudget struct {
+	// maxMonthlyCost limits the compute cost of the whole project, if set.
+	maxMonthlyCost *float64
+	// environments limits the compute cost per environment tag.
+	environments map[string]float64
+	// failOnExceeded turns exceeded budgets into plan errors instead of warnings.
+	failOnExceeded bool
+
+	mu sync.Mutex
+	// services caches the services of the project once listed.
+	services []*tsClient.Service
+	listed   bool
+	// planned holds the compute planned so far in this run, by plannedCompute.key.
+	planned map[string]plannedCompute
+}
+
+// plannedCompute is the compute a plan gives a service or read replica set.
+type plannedCompute struct {
+	// id is empty for services that do not exist yet.
+	id string
+	// parentID is the primary of a read replica, whose region and environment
+	// are used when the plan does not know them yet.
+	parentID    string
+	name        string
+	region      string
+	environment string
+	size        computeSize
+	nodes       int64
+}
+
+// key identifies the planned resource among the plans of a provider run.
+func (p plannedCompute) key() string {
+	if p.id != "" {
+		return p.id
+	}
+	return "new/" + p.parentID + "/" + p.name
+}
+
+// resolve fills in the region and environment of a read replica from its
+// primary when the plan does not know them yet.
+func (p plannedCompute) resolve(services []*tsClient.Service) plannedCompute {
+	if p.parentID == "" {
+		return p
+	}
+	for _, s := range services {
+		if s.ID != p.parentID {
+			continue
+		}
+		if p.region == "" {
+			p.region = s.RegionCode
+		}
+		if p.environment == "" {
+			p.environment = serviceEnvironment(s)
+		}
+	}
+	return p
+}
+
+// monthlyCost returns the monthly compute cost of the plan, and false if the
+// planned size has no price.
+func (p plannedCompute) monthlyCost(products []*tsClient.Product) (float64, bool) {
+	price, ok := hourlyPrice(products, p.region, p.size)
+	if !ok {
+		return 0, false
+	}
+	return price * float64(p.nodes) * hoursPerMonth, true
+}
+
+// projectedCost is the monthly compute cost of a project once a plan is applied.
+type projectedCost struct {
+	planned       float64
+	current       float64
+	total         float64
+	byEnvironment map[string]float64
+}
+
+// serviceMonthlyCost returns the current monthly compute cost of a service.
+// Paused services and services without a known price cost nothing. The
+// service queries do not select paused, so it is derived from the status.
+func serviceMonthlyCost(products []*tsClient.Product, s *tsClient.Service) float64 {
+	if isServicePaused(s) || len(s.Resources) == 0 {
+		return 0
+	}
+	spec := s.Resources[0].Spec
+	price, ok := hourlyPrice(products, s.RegionCode, computeSize{MilliCPU: spec.MilliCPU, MemoryGB: spec.MemoryGB})
+	if !ok {
+		return 0
+	}
+	// Read replicas report their nodes beyond the first as replicas, like HA
+	// replicas of a primary.
+	return price * float64(1+spec.ReplicaCount) * hoursPerMonth
+}
+
+func serviceEnvironment(s *tsClient.Service) string {
+	if s.Metadata == nil {
+		return ""
+	}
+	return s.Metadata.Environment
+}
+
+// projectCost returns the projected monthly cost of the project once p and
+// the other planned resources are applied. The planned cost of a resource
+// replaces its current cost; other plans without a price keep theirs. It
+// returns false if the size planned by p has no price.
+func projectCost(products []*tsClient.Product, services []*tsClient.Service, others []plannedCompute, p plannedCompute) (projectedCost, bool) {
+	p = p.resolve(services)
+	planned, ok := p.monthlyCost(products)
+	if !ok {
+		return projectedCost{}, false
+	}
+	cost := projectedCost{
+		planned:       planned,
+		byEnvironment: make(map[string]float64),
+	}
+	replaced := make(map[string]bool)
+	for _, o := range others {
+		o = o.resolve(services)
+		monthly, ok := o.monthlyCost(products)
+		if !ok {
+			continue
+		}
+		if o.id != "" {
+			replaced[o.id] = true
+		}
+		cost.total += monthly
+		cost.byEnvironment[strings.ToUpper(o.environment)] += monthly
+	}
+	for _, s := range services {
+		monthly := serviceMonthlyCost(products, s)
+		if p.id != "" && s.ID == p.id {
+			cost.current = monthly
+			continue
+		}
+		if replaced[s.ID] {
+			continue
+		}
+		cost.total += monthly
+		cost.byEnvironment[strings.ToUpper(serviceEnvironment(s))] += monthly
+	}
+	cost.total += cost.planned
+	cost.byEnvironment[strings.ToUpper(p.environment)] += cost.planned
+	return cost, true
+}
+
+// exceeded returns a message for every budget the projected cost is above.
+// Only the environment of the planned service is checked, the others are not
+// affected by its plan.
+func (b *costBudget) exceeded(cost projectedCost, p plannedCompute) []string {
+	detail := fmt.Sprintf("The planned resource costs $%.2f per month.", cost.planned)
+	if p.name != "" {
+		detail = fmt.Sprintf("%q is planned at $%.2f per month.", p.name, cost.planned)
+	}
+	var msgs []string
+	if b.maxMonthlyCost != nil && cost.total > *b.maxMonthlyCost {
+		msgs = append(msgs, fmt.Sprintf(errMaxMonthlyCost, cost.total, *b.maxMonthlyCost, detail))
+	}
+	for env, limit := range b.environments {
+		if p.environment == "" || !strings.EqualFold(env, p.environment) {
+			continue
+		}
+		if total := cost.byEnvironment[strings.ToUpper(p.environment)]; total > limit {
+			msgs = append(msgs, fmt.Sprintf(errEnvironmentBudget, env, total, limit, detail))
+		}
+	}
+	return msgs
+}
+
+// check records the plan and reports the budgets it would exceed together
+// with the plans made before it. Plans that do not raise the cost of the
+// resource are not checked, so an over-budget project can still be scaled
+// down.
+func (b *costBudget) check(ctx context.Context, client *tsClient.Client, catalog *productCatalog, p plannedCompute) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if b == nil || client == nil {
+		return diags
+	}
+	products, err := catalog.load(ctx)
+	if err != nil {
+		diags.AddWarning(errBudgetNotChecked, fmt.Sprintf("Unable to load the product catalog: %s", err))
+		return diags
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.listed {
+		services, err := client.GetAllServices(ctx)
+		if err != nil {
+			diags.AddWarning(errBudgetNotChecked, fmt.Sprintf("Unable to list the services of the project: %s", err))
+			return diags
+		}
+		b.services, b.listed = services, true
+	}
+	if b.planned == nil {
+		b.planned = make(map[string]plannedCompute)
+	}
+	others := make([]plannedCompute, 0, len(b.planned))
+	for key, o := range b.planned {
+		if key != p.key() {
+			others = append(others, o)
+		}
+	}
+
+	cost, ok := projectCost(products, b.services, others, p)
+	if !ok {
+		tflog.Debug(ctx, "No price for planned compute, budget not checked", map[string]any{"name": p.name, "region": p.region, "size": p.size.String()})
+		return diags
+	}
+	b.planned[p.key()] = p
+	if cost.planned <= cost.current {
+		return diags
+	}
+	for _, msg := range b.exceeded(cost, p) {
+		if b.failOnExceeded {
+			diags.AddError(errBudgetExceeded, msg)
+		} else {
+			diags.AddWarning(errBudgetExceeded, msg)
+		}
+	}
+	return diags
+}
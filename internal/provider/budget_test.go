@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// newBudgetTestService returns a running service of the given size and
+// replica count in us-east-1.
+func newBudgetTestService(id, env string, milliCPU, memoryGB, replicas int64) *tsClient.Service {
+	s := newTestService()
+	s.ID = id
+	s.Metadata = &tsClient.Metadata{Environment: env}
+	s.Resources[0].Spec.MilliCPU = milliCPU
+	s.Resources[0].Spec.MemoryGB = memoryGB
+	s.Resources[0].Spec.ReplicaCount = replicas
+	return s
+}
+
+func TestServiceMonthlyCost(t *testing.T) {
+	require.InDelta(t, 0.4384*2*hoursPerMonth, serviceMonthlyCost(testProducts, newBudgetTestService("a", "PROD", 2000, 8, 1)), 1e-9)
+
+	paused := newBudgetTestService("b", "DEV", 2000, 8, 0)
+	paused.Status = "PAUSED"
+	require.Zero(t, serviceMonthlyCost(testProducts, paused))
+	paused.Status = "PAUSING"
+	require.Zero(t, serviceMonthlyCost(testProducts, paused))
+
+	require.Zero(t, serviceMonthlyCost(testProducts, newBudgetTestService("c", "DEV", 64000, 256, 0)))
+}
+
+func TestProjectCost(t *testing.T) {
+	services := []*tsClient.Service{
+		newBudgetTestService("prod", "PROD", 2000, 8, 0),
+		newBudgetTestService("dev", "DEV", 500, 2, 0),
+	}
+	devMonthly := 0.0274 * hoursPerMonth
+	prodMonthly := 0.4384 * hoursPerMonth
+
+	// Resizing the dev service replaces its current cost
+	cost, ok := projectCost(testProducts, services, nil, plannedCompute{id: "dev", region: "us-east-1", environment: "DEV", size: computeSize{2000, 8}, nodes: 1})
+	require.True(t, ok)
+	require.InDelta(t, devMonthly, cost.current, 1e-9)
+	require.InDelta(t, prodMonthly, cost.planned, 1e-9)
+	require.InDelta(t, 2*prodMonthly, cost.total, 1e-9)
+	require.InDelta(t, prodMonthly, cost.byEnvironment["DEV"], 1e-9)
+
+	// A new read replica takes the region and environment of its primary
+	cost, ok = projectCost(testProducts, services, nil, plannedCompute{parentID: "prod", size: computeSize{500, 2}, nodes: 2})
+	require.True(t, ok)
+	require.Zero(t, cost.current)
+	require.InDelta(t, prodMonthly+3*devMonthly, cost.total, 1e-9)
+	require.InDelta(t, prodMonthly+2*devMonthly, cost.byEnvironment["PROD"], 1e-9)
+
+	// Other plans replace the current cost of their services and add new ones
+	others := []plannedCompute{
+		{id: "prod", region: "us-east-1", environment: "PROD", size: computeSize{500, 2}, nodes: 1},
+		{name: "new-replica", parentID: "prod", size: computeSize{2000, 8}, nodes: 1},
+		{name: "unpriced", region: "eu-central-1", size: computeSize{500, 2}, nodes: 1},
+	}
+	cost, ok = projectCost(testProducts, services, others, plannedCompute{id: "dev", region: "us-east-1", environment: "DEV", size: computeSize{2000, 8}, nodes: 1})
+	require.True(t, ok)
+	require.InDelta(t, devMonthly+2*prodMonthly, cost.total, 1e-9)
+	require.InDelta(t, devMonthly+prodMonthly, cost.byEnvironment["PROD"], 1e-9)
+
+	_, ok = projectCost(testProducts, services, nil, plannedCompute{region: "eu-central-1", size: computeSize{500, 2}, nodes: 1})
+	require.False(t, ok)
+}
+
+func TestCostBudget_Exceeded(t *testing.T) {
+	maxMonthlyCost := 500.0
+	b := &costBudget{maxMonthlyCost: &maxMonthlyCost, environments: map[string]float64{"DEV": 100}}
+	cost := projectedCost{planned: 320, total: 640, byEnvironment: map[string]float64{"DEV": 320, "PROD": 320}}
+
+	msgs := b.exceeded(cost, plannedCompute{name: "dev-db", environment: "DEV"})
+	require.Len(t, msgs, 2)
+	require.Contains(t, msgs[0], "$640.00, above the max_monthly_cost of $500.00")
+	require.Contains(t, msgs[0], `"dev-db" is planned at $320.00 per month`)
+	require.Contains(t, msgs[1], "DEV environment would be $320.00, above its budget of $100.00")
+
+	// Other environments are not affected by the plan
+	msgs = b.exceeded(cost, plannedCompute{name: "prod-db", environment: "PROD"})
+	require.Len(t, msgs, 1)
+
+	maxMonthlyCost = 1000
+	require.Empty(t, b.exceeded(cost, plannedCompute{environment: "prod"}))
+	require.Len(t, b.exceeded(cost, plannedCompute{environment: "dev"}), 1)
+}
+
+func TestCostBudget_CheckSumsPlans(t *testing.T) {
+	maxMonthlyCost := 500.0
+	b := &costBudget{
+		maxMonthlyCost: &maxMonthlyCost,
+		failOnExceeded: true,
+		services:       []*tsClient.Service{newBudgetTestService("dev", "DEV", 500, 2, 0)},
+		listed:         true,
+	}
+	client, catalog := &tsClient.Client{}, newTestCatalog(testProducts)
+
+	// Each new service fits on its own, the second one exceeds the budget
+	// together with the first.
+	require.False(t, b.check(t.Context(), client, catalog, plannedCompute{name: "a", region: "us-east-1", size: computeSize{2000, 8}, nodes: 1}).HasError())
+	diags := b.check(t.Context(), client, catalog, plannedCompute{name: "b", region: "us-east-1", size: computeSize{2000, 8}, nodes: 1})
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), `"b" is planned at $320.03 per month`)
+
+	// Planning the same resource again replaces its earlier plan
+	require.False(t, b.check(t.Context(), client, catalog, plannedCompute{name: "b", region: "us-east-1", size: computeSize{500, 2}, nodes: 1}).HasError())
+	require.Len(t, b.planned, 2)
+}
+
+func TestCostBudget_CheckWithoutBudget(t *testing.T) {
+	var b *costBudget
+	require.Empty(t, b.check(t.Context(), nil, nil, plannedCompute{}))
+}
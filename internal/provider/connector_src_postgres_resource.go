@@ -305,15 +305,15 @@ func (r *connectorSrcPostgresResource) Configure(ctx context.Context, req resour
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*tsClient.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *tsClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 // Create creates a new PostgreSQL source connector.
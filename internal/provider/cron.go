@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed standard 5-field cron expression
+// (minute hour day-of-month month day-of-week).
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny record a "*" day field. As in cron, when both day
+	// fields are restricted a time matches if either of them matches.
+	domAny, dowAny bool
+}
+
+// cronSearchLimit bounds how far prev and next look for a matching time.
+const cronSearchLimit = 366 * 24 * time.Hour
+
+var (
+	cronMonthNames = map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12}
+	cronDayNames   = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+)
+
+// parseCron parses a cron expression such as "0 20 * * MON-FRI".
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+	var (
+		c   cronSchedule
+		err error
+	)
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute in %q: %w", expr, err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour in %q: %w", expr, err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month in %q: %w", expr, err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month in %q: %w", expr, err)
+	}
+	// 7 is accepted as Sunday, as in most cron implementations.
+	if c.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week in %q: %w", expr, err)
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+	return &c, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps
+// into a bitset.
+func parseCronField(field string, lower, upper int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, s, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", s)
+			}
+			part, step = base, n
+		}
+		start, end := lower, upper
+		if part != "*" {
+			from, to, isRange := strings.Cut(part, "-")
+			var err error
+			if start, err = parseCronValue(from, lower, upper, names); err != nil {
+				return 0, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronValue(to, lower, upper, names); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				end = upper
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, lower, upper int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < lower || v > upper {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, lower, upper)
+	}
+	return v, nil
+}
+
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dow
+	case c.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// matches reports whether t, truncated to the minute, is a time of the schedule.
+func (c *cronSchedule) matches(t time.Time) bool {
+	return c.month&(1<<uint(t.Month())) != 0 &&
+		c.matchesDay(t) &&
+		c.hour&(1<<uint(t.Hour())) != 0 &&
+		c.minute&(1<<uint(t.Minute())) != 0
+}
+
+// prev returns the latest time of the schedule at or before t, evaluated in
+// t's location. It returns false when there is none within a year.
+func (c *cronSchedule) prev(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	limit := t.Add(-cronSearchLimit)
+	for t.After(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0 || !c.matchesDay(t):
+			// Jump to the last minute of the previous day.
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Add(-time.Duration(t.Minute()+1) * time.Minute)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// next returns the earliest time of the schedule strictly after t, evaluated
+// in t's location. It returns false when there is none within a year.
+func (c *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0 || !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cronWindowActive reports whether a window that opens at the times of start
+// and closes at the times of end is open at now: its latest opening is more
+// recent than its latest closing. Times are evaluated in now's location.
+func cronWindowActive(start, end string, now time.Time) (bool, error) {
+	startAt, err := parseCron(start)
+	if err != nil {
+		return false, err
+	}
+	endAt, err := parseCron(end)
+	if err != nil {
+		return false, err
+	}
+	lastStart, started := startAt.prev(now)
+	if !started {
+		return false, nil
+	}
+	lastEnd, ended := endAt.prev(now)
+	return !ended || lastStart.After(lastEnd), nil
+}
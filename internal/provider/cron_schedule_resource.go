@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// cronScheduleEvaluation documents how the schedules of the resources built on
+// cronScheduleResource take effect.
+const cronScheduleEvaluation = `The schedule is evaluated by the provider whenever a plan is made, and the apply brings the service in line with it.
+Nothing runs between two applies, so the schedule only takes effect if Terraform runs regularly, for example from a scheduled CI job.`
+
+// cronScheduleResource is embedded by the resources that drive a service from
+// cron windows. The windows are evaluated by the provider: every plan computes
+// what the service should currently look like and the apply reconciles it.
+type cronScheduleResource struct {
+	client *tsClient.Client
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+// cronWindow is a window that opens at the times of the start cron expression
+// and closes at the following time of the end one.
+type cronWindow struct {
+	start string
+	end   string
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cronScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "cronScheduleResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+	r.now = time.Now
+}
+
+// ImportState imports the schedule of a service by its ID. The windows are
+// not known to the API and have to be set in the configuration.
+func (r *cronScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// reconcileService fetches the service and passes it to sync along with the
+// current time. When sync changed the service, it waits for the service to be
+// ready again.
+func (r *cronScheduleResource) reconcileService(ctx context.Context, serviceID string, timeout time.Duration, sync func(service *tsClient.Service, now time.Time) (changed bool, err error)) error {
+	service, err := r.client.GetService(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	changed, err := sync(service, r.now())
+	if err != nil || !changed {
+		return err
+	}
+	_, err = waitForServiceReadiness(ctx, r.client, serviceID, timeout)
+	return err
+}
+
+// cronScheduleAttributes returns the attributes every cron schedule resource
+// has, the resource adds its windows and desired state to them.
+func cronScheduleAttributes(ctx context.Context, serviceIDDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of this resource. Same as `service_id`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			MarkdownDescription: serviceIDDescription,
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"timezone": schema.StringAttribute{
+			MarkdownDescription: "IANA time zone the cron expressions are evaluated in, e.g. `Europe/Madrid`. Defaults to `UTC`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("UTC"),
+			Validators: []validator.String{
+				timezoneValidator{},
+			},
+		},
+		"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+			Create: true,
+			Update: true,
+		}),
+	}
+}
+
+// activeCronWindow returns the index of the first window active at now in the
+// given time zone, or -1 when none is.
+func activeCronWindow(windows []cronWindow, timezone string, now time.Time) (int, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return -1, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	now = now.In(loc)
+	for i, w := range windows {
+		active, err := cronWindowActive(w.start, w.end, now)
+		if err != nil {
+			return -1, err
+		}
+		if active {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// cronValidator validates a 5-field cron expression.
+type cronValidator struct{}
+
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a 5-field cron expression"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Cron Expression", err.Error())
+	}
+}
+
+// timezoneValidator validates an IANA time zone name.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone such as UTC or Europe/Madrid"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone", err.Error())
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &deleteExpiredServicesAction{}
+	_ action.ActionWithConfigure = &deleteExpiredServicesAction{}
+)
+
+// NewDeleteExpiredServicesAction is a helper function to simplify the provider implementation.
+func NewDeleteExpiredServicesAction() action.Action {
+	return &deleteExpiredServicesAction{}
+}
+
+// deleteExpiredServicesAction deletes the services of the project that are
+// past their expiry.
+type deleteExpiredServicesAction struct {
+	client *tsClient.Client
+}
+
+type deleteExpiredServicesActionModel struct {
+	Tags   types.Map  `tfsdk:"tags"`
+	DryRun types.Bool `tfsdk:"dry_run"`
+}
+
+func (a *deleteExpiredServicesAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delete_expired_services"
+}
+
+func (a *deleteExpiredServicesAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Deletes the services of the project that are past the time in their ` + "`expires_at`" + ` tag, set by the ` + "`expires_at`" + ` or ` + "`ttl`" + ` of ` + "`timescale_service`" + `.
+
+Use it to clean up short-lived services, such as CI previews, whose pipeline did not destroy them. Services still managed by
+a configuration are created again on its next apply. Requires Terraform 1.14 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only delete expired services that have all of these tags with the same values.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Only report the services that would be deleted. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *deleteExpiredServicesAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Trace(ctx, "deleteExpiredServicesAction.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = data.client
+}
+
+func (a *deleteExpiredServicesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "deleteExpiredServicesAction.Invoke")
+	var config deleteExpiredServicesActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var filter map[string]string
+	if !config.Tags.IsNull() {
+		resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &filter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	services, err := a.client.GetAllServices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Services", err.Error())
+		return
+	}
+	expired := expiredServices(services, time.Now(), filter)
+	if len(expired) == 0 {
+		resp.SendProgress(action.InvokeProgressEvent{Message: "No expired services found"})
+		return
+	}
+	for _, s := range expired {
+		expiresAt := s.Metadata.TagMap()[expiryTagKey]
+		if config.DryRun.ValueBool() {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Would delete service %s (%s), expired at %s", s.Name, s.ID, expiresAt)})
+			continue
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Deleting service %s (%s), expired at %s", s.Name, s.ID, expiresAt)})
+		if _, err := a.client.DeleteService(ctx, s.ID); err != nil && !errors.Is(err, tsClient.ErrServiceNotFound) {
+			// Keep going, a failure should not leave the other services behind.
+			resp.Diagnostics.AddError("Unable to Delete Service", fmt.Sprintf("Could not delete service %s (%s): %s", s.Name, s.ID, err))
+		}
+	}
+}
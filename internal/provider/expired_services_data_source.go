@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &expiredServicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &expiredServicesDataSource{}
+
+func NewExpiredServicesDataSource() datasource.DataSource {
+	return &expiredServicesDataSource{}
+}
+
+// expiredServicesDataSource lists the services of the project past their expiry.
+type expiredServicesDataSource struct {
+	client *tsClient.Client
+}
+
+type expiredServicesDataSourceModel struct {
+	Tags     types.Map                        `tfsdk:"tags"`
+	Services []expiredServicesDataSourceEntry `tfsdk:"services"`
+	// following is a placeholder, required by terraform to run test suite
+	ID types.String `tfsdk:"id"`
+}
+
+type expiredServicesDataSourceEntry struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	RegionCode types.String `tfsdk:"region_code"`
+	Status     types.String `tfsdk:"status"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (d *expiredServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expired_services"
+}
+
+func (d *expiredServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the services of the project that are past the time in their `expires_at` tag, set by the `expires_at` or `ttl` of `timescale_service`. Delete them with the `timescale_delete_expired_services` action.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only return services that have all of these tags with the same values.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Expired services, read replicas first and then sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Service ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Service name.",
+							Computed:            true,
+						},
+						"region_code": schema.StringAttribute{
+							MarkdownDescription: "Region the service is located in.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Service status, e.g. `READY` or `PAUSED`.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "Time the service expired at.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *expiredServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "ExpiredServicesDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Client Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *expiredServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "ExpiredServicesDataSource.Read")
+
+	var state expiredServicesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var filter map[string]string
+	if !state.Tags.IsNull() {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &filter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	services, err := d.client.GetAllServices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list services, got error: %s", err))
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+	state.Services = []expiredServicesDataSourceEntry{}
+	for _, s := range expiredServices(services, time.Now(), filter) {
+		state.Services = append(state.Services, expiredServicesDataSourceEntry{
+			ID:         types.StringValue(s.ID),
+			Name:       types.StringValue(s.Name),
+			RegionCode: types.StringValue(s.RegionCode),
+			Status:     types.StringValue(s.Status),
+			ExpiresAt:  types.StringValue(s.Metadata.TagMap()[expiryTagKey]),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
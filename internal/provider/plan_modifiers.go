@@ -24,6 +24,10 @@ func useStateUnlessToggleChangesInt64(togglePaths ...string) planmodifier.Int64
 	return &int64TogglePlanModifier{togglePaths: togglePaths}
 }
 
+func useStateUnlessToggleChangesList(togglePaths ...string) planmodifier.List {
+	return &listTogglePlanModifier{togglePaths: togglePaths}
+}
+
 type stringTogglePlanModifier struct {
 	togglePaths []string
 }
@@ -82,6 +86,35 @@ func (m *int64TogglePlanModifier) PlanModifyInt64(ctx context.Context, req planm
 	resp.PlanValue = req.StateValue
 }
 
+type listTogglePlanModifier struct {
+	togglePaths []string
+}
+
+func (m *listTogglePlanModifier) Description(_ context.Context) string {
+	return "Use state value unless " + strings.Join(m.togglePaths, " or ") + " changes"
+}
+
+func (m *listTogglePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *listTogglePlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	if anyToggleChanged(ctx, req.State, req.Plan, m.togglePaths) {
+		resp.PlanValue = types.ListUnknown(req.PlanValue.ElementType(ctx))
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
 func anyToggleChanged(ctx context.Context, state tfsdk.State, plan tfsdk.Plan, togglePaths []string) bool {
 	for _, togglePath := range togglePaths {
 		if toggleChanged(ctx, state, plan, togglePath) {
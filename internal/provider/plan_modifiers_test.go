@@ -350,3 +350,34 @@ func TestServiceSchema_EndpointsPreservedWhenTogglesUnchanged(t *testing.T) {
 		}
 	})
 }
+
+// TestServiceSchema_EndpointsListRefreshesOnVpcChange verifies that the
+// endpoints list is kept from state unless the VPC attachment changes.
+func TestServiceSchema_EndpointsListRefreshesOnVpcChange(t *testing.T) {
+	s := getServiceSchema(t)
+	listAttr, ok := s.Attributes["endpoints"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatal("endpoints attribute is not a ListNestedAttribute")
+	}
+	stateEndpoints := endpointsToList([]serviceEndpoint{{role: "primary", host: "svc.example.com", port: 5432, visibility: endpointPublic}})
+
+	for name, planVpcID := range map[string]int{"unchanged": 100, "changed": 200} {
+		t.Run(name, func(t *testing.T) {
+			stateRaw := buildTFValues(t, s, map[string]tftypes.Value{"vpc_id": tftypes.NewValue(tftypes.Number, 100)})
+			planRaw := buildTFValues(t, s, map[string]tftypes.Value{"vpc_id": tftypes.NewValue(tftypes.Number, planVpcID)})
+			req := planmodifier.ListRequest{
+				PlanValue:  types.ListUnknown(stateEndpoints.ElementType(context.Background())),
+				StateValue: stateEndpoints,
+				State:      tfsdk.State{Schema: s, Raw: stateRaw},
+				Plan:       tfsdk.Plan{Schema: s, Raw: planRaw},
+			}
+			resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+			for _, mod := range listAttr.PlanModifiers {
+				mod.PlanModifyList(context.Background(), req, resp)
+			}
+			if wantUnknown := name == "changed"; resp.PlanValue.IsUnknown() != wantUnknown {
+				t.Errorf("endpoints unknown = %v, want %v", resp.PlanValue.IsUnknown(), wantUnknown)
+			}
+		})
+	}
+}
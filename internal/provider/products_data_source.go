@@ -111,7 +111,9 @@ func (d *productsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client, _ = req.ProviderData.(*tsClient.Client)
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
 }
 
 // Schema defines the schema for the data source.
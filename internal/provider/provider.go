@@ -4,12 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -18,6 +24,8 @@ import (
 
 // Ensure TimescaleProvider satisfies various provider interfaces.
 var _ provider.ProviderWithConfigValidators = &timescaleProvider{}
+var _ provider.ProviderWithActions = &timescaleProvider{}
+var _ provider.ProviderWithEphemeralResources = &timescaleProvider{}
 
 // timescaleProvider is the provider implementation.
 type timescaleProvider struct {
@@ -40,10 +48,30 @@ func New(version string) func() provider.Provider {
 
 // TimescaleProviderModel describes the provider data model.
 type TimescaleProviderModel struct {
-	ProjectID   types.String `tfsdk:"project_id"`
-	AccessToken types.String `tfsdk:"access_token"`
-	AccessKey   types.String `tfsdk:"access_key"`
-	SecretKey   types.String `tfsdk:"secret_key"`
+	ProjectID               types.String  `tfsdk:"project_id"`
+	AccessToken             types.String  `tfsdk:"access_token"`
+	AccessKey               types.String  `tfsdk:"access_key"`
+	SecretKey               types.String  `tfsdk:"secret_key"`
+	DefaultTags             types.Map     `tfsdk:"default_tags"`
+	FailOnDisruptiveChanges types.Bool    `tfsdk:"fail_on_disruptive_changes"`
+	MaxMonthlyCost          types.Float64 `tfsdk:"max_monthly_cost"`
+	EnvironmentBudgets      types.Map     `tfsdk:"environment_budgets"`
+	FailOnBudgetExceeded    types.Bool    `tfsdk:"fail_on_budget_exceeded"`
+}
+
+// providerData is handed to resources and data sources on Configure. It
+// carries the API client and the provider-level settings they depend on.
+type providerData struct {
+	client *tsClient.Client
+	// defaultTags are merged into the tags of every taggable resource.
+	defaultTags map[string]string
+	// failOnDisruptiveChanges rejects plans with service changes that cause
+	// downtime, dropped connections or data loss.
+	failOnDisruptiveChanges bool
+	// catalog caches the product plans used to validate compute sizes.
+	catalog *productCatalog
+	// budget limits the projected compute cost of plans, nil if no limit is set.
+	budget *costBudget
 }
 
 func (p *timescaleProvider) Metadata(ctx context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -76,6 +104,34 @@ func (p *timescaleProvider) Schema(ctx context.Context, _ provider.SchemaRequest
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"default_tags": schema.MapAttribute{
+				MarkdownDescription: "Tags applied to every taggable resource. Tags set on a resource take precedence over these.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          tagsValidators,
+			},
+			"fail_on_disruptive_changes": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan when a `timescale_service` change would drop connections, cause downtime or replace the service, instead of only warning about it. Useful to protect production environments. Defaults to `false`.",
+				Optional:            true,
+			},
+			"max_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "Maximum projected monthly compute cost of the project. Plans of `timescale_service` and `timescale_read_replica_set` that raise the cost of the project above it fail, see `fail_on_budget_exceeded`. The cost is estimated from the product prices, like `estimated_monthly_cost`, and each resource is checked against the current cost of the project plus the resources planned before it in the same run, so the last resource planned reports a budget that the plan as a whole exceeds.",
+				Optional:            true,
+				Validators:          []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"environment_budgets": schema.MapAttribute{
+				MarkdownDescription: "Maximum projected monthly compute cost per environment tag, such as `{ DEV = 200 }`. Works like `max_monthly_cost` for the services with the given `environment_tag`.",
+				ElementType:         types.Float64Type,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf("DEV", "PROD")),
+					mapvalidator.ValueFloat64sAre(float64validator.AtLeast(0)),
+				},
+			},
+			"fail_on_budget_exceeded": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan when `max_monthly_cost` or `environment_budgets` would be exceeded. Set to `false` to only warn. Defaults to `true`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -122,8 +178,30 @@ func (p *timescaleProvider) Configure(ctx context.Context, req provider.Configur
 			return
 		}
 	}
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	pd := &providerData{client: client, failOnDisruptiveChanges: data.FailOnDisruptiveChanges.ValueBool(), catalog: newProductCatalog(client)}
+	if !data.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &pd.defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !data.MaxMonthlyCost.IsNull() || !data.EnvironmentBudgets.IsNull() {
+		pd.budget = &costBudget{failOnExceeded: data.FailOnBudgetExceeded.IsNull() || data.FailOnBudgetExceeded.ValueBool()}
+		if !data.MaxMonthlyCost.IsNull() {
+			maxMonthlyCost := data.MaxMonthlyCost.ValueFloat64()
+			pd.budget.maxMonthlyCost = &maxMonthlyCost
+		}
+		if !data.EnvironmentBudgets.IsNull() {
+			resp.Diagnostics.Append(data.EnvironmentBudgets.ElementsAs(ctx, &pd.budget.environments, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	resp.DataSourceData = pd
+	resp.ResourceData = pd
+	resp.ActionData = pd
+	resp.EphemeralResourceData = pd
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -132,6 +210,9 @@ func (p *timescaleProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		NewProductsDataSource,
 		NewServiceDataSource,
+		NewServiceIPAllowlistDataSource,
+		NewServicesDataSource,
+		NewExpiredServicesDataSource,
 		NewVpcsDataSource,
 	}
 }
@@ -141,6 +222,12 @@ func (p *timescaleProvider) Resources(ctx context.Context) []func() resource.Res
 	tflog.Trace(ctx, "TimescaleProvider.Resources")
 	return []func() resource.Resource{
 		NewServiceResource,
+		NewServiceParametersResource,
+		NewServiceIPAllowlistResource,
+		NewServiceScheduleResource,
+		NewServiceScalingScheduleResource,
+		NewServicePasswordRotationResource,
+		NewReadReplicaSetResource,
 		NewVpcsResource,
 		NewPeeringConnectionResource,
 		NewMetricExporterResource,
@@ -149,3 +236,22 @@ func (p *timescaleProvider) Resources(ctx context.Context) []func() resource.Res
 		NewConnectorSrcPostgresResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *timescaleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	tflog.Trace(ctx, "TimescaleProvider.EphemeralResources")
+	return []func() ephemeral.EphemeralResource{
+		NewServiceCredentialsEphemeralResource,
+		NewServicePasswordEphemeralResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *timescaleProvider) Actions(ctx context.Context) []func() action.Action {
+	tflog.Trace(ctx, "TimescaleProvider.Actions")
+	return []func() action.Action{
+		NewServiceRestartAction,
+		NewServiceSwitchoverAction,
+		NewDeleteExpiredServicesAction,
+	}
+}
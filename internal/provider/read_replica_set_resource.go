@@ -0,0 +1,555 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+	multiplyvalidator "github.com/timescale/terraform-provider-timescale/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &readReplicaSetResource{}
+	_ resource.ResourceWithConfigure   = &readReplicaSetResource{}
+	_ resource.ResourceWithImportState = &readReplicaSetResource{}
+	_ resource.ResourceWithMoveState   = &readReplicaSetResource{}
+	_ resource.ResourceWithModifyPlan  = &readReplicaSetResource{}
+)
+
+const errNotReadReplica = "service %s is not a read replica"
+
+var replicaEndpointAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"host": types.StringType,
+	"port": types.Int64Type,
+}
+
+// NewReadReplicaSetResource is a helper function to simplify the provider implementation.
+func NewReadReplicaSetResource() resource.Resource {
+	return &readReplicaSetResource{}
+}
+
+// readReplicaSetResource manages a read replica set of a primary service.
+type readReplicaSetResource struct {
+	client  *tsClient.Client
+	catalog *productCatalog
+	budget  *costBudget
+}
+
+type readReplicaSetResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	PrimaryServiceID        types.String   `tfsdk:"primary_service_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Nodes                   types.Int64    `tfsdk:"nodes"`
+	MilliCPU                types.Int64    `tfsdk:"milli_cpu"`
+	MemoryGB                types.Int64    `tfsdk:"memory_gb"`
+	ConnectionPoolerEnabled types.Bool     `tfsdk:"connection_pooler_enabled"`
+	RegionCode              types.String   `tfsdk:"region_code"`
+	Hostname                types.String   `tfsdk:"hostname"`
+	Port                    types.Int64    `tfsdk:"port"`
+	PoolerHostname          types.String   `tfsdk:"pooler_hostname"`
+	PoolerPort              types.Int64    `tfsdk:"pooler_port"`
+	Endpoints               types.List     `tfsdk:"endpoints"`
+	EstimatedHourlyCost     types.Float64  `tfsdk:"estimated_hourly_cost"`
+	EstimatedMonthlyCost    types.Float64  `tfsdk:"estimated_monthly_cost"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *readReplicaSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_read_replica_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *readReplicaSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A set of read replica nodes of a primary service.
+
+The replicas serve read-only queries and use the password of the primary service. Read replicas created with the
+` + "`read_replica_source`" + ` attribute of ` + "`timescale_service`" + `, which is deprecated and will be removed in the next major version,
+can be moved to this resource with a ` + "`moved`" + ` block (Terraform 1.8+), without recreating them:
+
+` + "```" + `terraform
+moved {
+  from = timescale_service.replica
+  to   = timescale_read_replica_set.replica
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The service ID of the read replica set.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service to replicate. Changing it creates a new read replica set.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the read replica set. Defaults to `replica-<primary name>`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nodes": schema.Int64Attribute{
+				MarkdownDescription: "Number of read replica nodes (1-10). Defaults to 1.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"milli_cpu": schema.Int64Attribute{
+				MarkdownDescription: "Milli CPU of each node.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(DefaultMilliCPU),
+				Validators: []validator.Int64{
+					int64validator.OneOf(milliCPUSizes...),
+					multiplyvalidator.EqualToMultipleOf(250, path.Expressions{
+						path.MatchRoot("memory_gb"),
+					}...),
+				},
+			},
+			"memory_gb": schema.Int64Attribute{
+				MarkdownDescription: "Memory GB of each node.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(DefaultMemoryGB),
+				Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+			},
+			"connection_pooler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enables a connection pooler for the read replica set, independently of the primary service.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"region_code": schema.StringAttribute{
+				MarkdownDescription: "The region of the read replica set, the same as the primary service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname balancing connections across the nodes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port balancing connections across the nodes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"pooler_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the connection pooler.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("connection_pooler_enabled"),
+				},
+			},
+			"pooler_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the connection pooler.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessToggleChangesInt64("connection_pooler_enabled"),
+				},
+			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "Endpoint of each node, to connect to a specific node.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node.",
+							Computed:            true,
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the node.",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the node.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"estimated_hourly_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated compute cost of the read replica set per hour, from the price of the plan for its `region_code` and size, times its `nodes`. Storage is not included. Known at plan time once the read replica set exists, so cost changes show up in the plan. Null if no plan is found, for example when the product catalog is unavailable.",
+				Description:         "Estimated compute cost of the read replica set per hour, from the price of the plan for its region_code and size, times its nodes. Storage is not included. Known at plan time once the read replica set exists, so cost changes show up in the plan. Null if no plan is found, for example when the product catalog is unavailable.",
+				Computed:            true,
+			},
+			"estimated_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated compute cost of the read replica set per month of 730 hours, see `estimated_hourly_cost`.",
+				Description:         "Estimated compute cost of the read replica set per month of 730 hours, see estimated_hourly_cost.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *readReplicaSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "readReplicaSetResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+	r.catalog = data.catalog
+	r.budget = data.budget
+}
+
+// ModifyPlan plans the cost estimates of the read replica set and checks its
+// planned compute against the budgets of the provider.
+func (r *readReplicaSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan readReplicaSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hourly, monthly := r.estimateReplicaSetCost(ctx, plan)
+	planCost(ctx, resp, plan.EstimatedHourlyCost, hourly, monthly)
+
+	if r.budget == nil || plan.Nodes.IsUnknown() || plan.MilliCPU.IsUnknown() || plan.MemoryGB.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(r.budget.check(ctx, r.client, r.catalog, plannedCompute{
+		id:       plan.ID.ValueString(),
+		parentID: plan.PrimaryServiceID.ValueString(),
+		name:     plan.Name.ValueString(),
+		region:   plan.RegionCode.ValueString(),
+		size:     computeSize{MilliCPU: plan.MilliCPU.ValueInt64(), MemoryGB: plan.MemoryGB.ValueInt64()},
+		nodes:    plan.Nodes.ValueInt64(),
+	})...)
+}
+
+// Create creates the read replica set and waits for it to be ready.
+func (r *readReplicaSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "readReplicaSetResource.Create")
+	var plan readReplicaSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primaryID := plan.PrimaryServiceID.ValueString()
+	primary, err := r.client.GetService(ctx, primaryID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to get primary service %s, got error: %s", primaryID, err))
+		return
+	}
+	if primary.ForkSpec != nil {
+		resp.Diagnostics.AddError("read replica validation error", errReplicaFromFork)
+		return
+	}
+
+	request := tsClient.CreateServiceRequest{
+		Name:                   plan.Name.ValueString(),
+		MilliCPU:               strconv.FormatInt(plan.MilliCPU.ValueInt64(), 10),
+		MemoryGB:               strconv.FormatInt(plan.MemoryGB.ValueInt64(), 10),
+		RegionCode:             primary.RegionCode,
+		ReplicaCount:           strconv.FormatInt(plan.Nodes.ValueInt64()-1, 10), // API expects nodes - 1
+		SyncReplicaCount:       "0",                                              // Read replicas don't support sync replicas
+		EnableConnectionPooler: plan.ConnectionPoolerEnabled.ValueBool(),
+		ForkConfig: &tsClient.ForkConfig{
+			ProjectID: primary.ProjectID,
+			ServiceID: primary.ID,
+			IsStandby: true,
+		},
+	}
+	if request.Name == "" {
+		request.Name = "replica-" + primary.Name
+	}
+	if len(primary.Resources) > 0 {
+		request.StorageGB = strconv.FormatInt(primary.Resources[0].Spec.StorageGB, 10)
+	}
+
+	response, err := createReadReplicaWithRetry(ctx, r.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create read replica set, got error: %s", err))
+		return
+	}
+
+	service, err := r.waitForReadiness(ctx, response.Service.ID, plan.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(ErrCreateTimeout, fmt.Sprintf("error occurred while waiting for read replica set deployment, got error: %s", err))
+		// If we receive an error, attempt to delete the service to avoid having an orphaned instance.
+		if _, err := r.client.DeleteService(context.Background(), response.Service.ID); err != nil {
+			resp.Diagnostics.AddWarning("Error Deleting Resource", "error occurred attempting to delete the resource that timed out, please check your Timescale account to verify there is no unexpected service running from Terraform")
+		}
+		return
+	}
+
+	model := readReplicaSetToResource(&resp.Diagnostics, service, plan)
+	model.EstimatedHourlyCost, model.EstimatedMonthlyCost = appliedCost(plan.EstimatedHourlyCost, plan.EstimatedMonthlyCost, func() (types.Float64, types.Float64) {
+		return r.estimateReplicaSetCost(ctx, model)
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read refreshes the read replica set from the API.
+func (r *readReplicaSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "readReplicaSetResource.Read")
+	var state readReplicaSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetService(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Read replica set not found, removing from state.", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read read replica set, got error: %s", err))
+		return
+	}
+	if service.ForkSpec == nil || !service.ForkSpec.IsStandby {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(errNotReadReplica, service.ID))
+		return
+	}
+
+	model := readReplicaSetToResource(&resp.Diagnostics, service, state)
+	hourly, monthly := r.estimateReplicaSetCost(ctx, model)
+	model.EstimatedHourlyCost, model.EstimatedMonthlyCost = refreshedCost(hourly, monthly, state.EstimatedHourlyCost, state.EstimatedMonthlyCost)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update applies the changes in place and waits for the read replica set to be ready.
+func (r *readReplicaSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "readReplicaSetResource.Update")
+	var plan, state readReplicaSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		if err := r.client.RenameService(ctx, id, plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to rename read replica set", err.Error())
+			return
+		}
+	}
+	if !plan.ConnectionPoolerEnabled.Equal(state.ConnectionPoolerEnabled) {
+		if err := r.client.ToggleConnectionPooler(ctx, id, plan.ConnectionPoolerEnabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Failed to toggle connection pooler", err.Error())
+			return
+		}
+	}
+	if !plan.Nodes.Equal(state.Nodes) {
+		// API expects nodes - 1
+		if err := r.client.SetReplicaCount(ctx, id, int(plan.Nodes.ValueInt64()-1), 0); err != nil {
+			resp.Diagnostics.AddError("Failed to update read replica nodes", err.Error())
+			return
+		}
+	}
+	if !plan.MilliCPU.Equal(state.MilliCPU) || !plan.MemoryGB.Equal(state.MemoryGB) {
+		err := r.client.ResizeInstance(ctx, id, tsClient.ResourceConfig{
+			MilliCPU: strconv.FormatInt(plan.MilliCPU.ValueInt64(), 10),
+			MemoryGB: strconv.FormatInt(plan.MemoryGB.ValueInt64(), 10),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resize read replica set", err.Error())
+			return
+		}
+	}
+
+	service, err := r.waitForReadiness(ctx, id, plan.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(ErrCreateTimeout, fmt.Sprintf("error occurred while waiting for read replica set reconfiguration, got error: %s", err))
+		return
+	}
+	model := readReplicaSetToResource(&resp.Diagnostics, service, plan)
+	model.EstimatedHourlyCost, model.EstimatedMonthlyCost = appliedCost(plan.EstimatedHourlyCost, plan.EstimatedMonthlyCost, func() (types.Float64, types.Float64) {
+		return r.estimateReplicaSetCost(ctx, model)
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Delete deletes the read replica set. The primary service is not affected.
+func (r *readReplicaSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "readReplicaSetResource.Delete")
+	var state readReplicaSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := r.client.DeleteService(ctx, state.ID.ValueString()); err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Read Replica Set", "Could not delete read replica set, unexpected error: "+err.Error())
+	}
+}
+
+// ImportState imports a read replica set by its service ID.
+func (r *readReplicaSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// MoveState moves a read replica managed as a timescale_service with
+// read_replica_source to this resource.
+func (r *readReplicaSetResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "timescale_service" || req.SourceRawState == nil {
+					return
+				}
+				var source struct {
+					ID                      string `json:"id"`
+					Name                    string `json:"name"`
+					ReadReplicaSource       string `json:"read_replica_source"`
+					ReadReplicaNodes        *int64 `json:"read_replica_nodes"`
+					MilliCPU                int64  `json:"milli_cpu"`
+					MemoryGB                int64  `json:"memory_gb"`
+					ConnectionPoolerEnabled bool   `json:"connection_pooler_enabled"`
+					RegionCode              string `json:"region_code"`
+				}
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError("Unable to Move Resource State", err.Error())
+					return
+				}
+				if source.ReadReplicaSource == "" {
+					resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf(errNotReadReplica, source.ID)+", only read replicas can be moved to timescale_read_replica_set")
+					return
+				}
+				nodes := int64(1)
+				if source.ReadReplicaNodes != nil {
+					nodes = *source.ReadReplicaNodes
+				}
+				// Endpoints are filled in by the refresh that follows the move.
+				model := readReplicaSetResourceModel{
+					ID:                      types.StringValue(source.ID),
+					PrimaryServiceID:        types.StringValue(source.ReadReplicaSource),
+					Name:                    types.StringValue(source.Name),
+					Nodes:                   types.Int64Value(nodes),
+					MilliCPU:                types.Int64Value(source.MilliCPU),
+					MemoryGB:                types.Int64Value(source.MemoryGB),
+					ConnectionPoolerEnabled: types.BoolValue(source.ConnectionPoolerEnabled),
+					RegionCode:              types.StringValue(source.RegionCode),
+					Hostname:                types.StringNull(),
+					Port:                    types.Int64Null(),
+					PoolerHostname:          types.StringNull(),
+					PoolerPort:              types.Int64Null(),
+					Endpoints:               types.ListNull(types.ObjectType{AttrTypes: replicaEndpointAttrTypes}),
+					EstimatedHourlyCost:     types.Float64Null(),
+					EstimatedMonthlyCost:    types.Float64Null(),
+					Timeouts: timeouts.Value{
+						Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType}),
+					},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, model)...)
+			},
+		},
+	}
+}
+
+func (r *readReplicaSetResource) waitForReadiness(ctx context.Context, id string, timeouts timeouts.Value) (*tsClient.Service, error) {
+	timeout, diags := timeouts.Create(ctx, defaultServiceTimeout)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to get timeout from config %v", diags.Errors())
+	}
+	return waitForServiceReadiness(ctx, r.client, id, timeout)
+}
+
+// readReplicaSetToResource maps a read replica service to the resource model.
+func readReplicaSetToResource(diags *diag.Diagnostics, s *tsClient.Service, state readReplicaSetResourceModel) readReplicaSetResourceModel {
+	model := readReplicaSetResourceModel{
+		ID:                      types.StringValue(s.ID),
+		PrimaryServiceID:        state.PrimaryServiceID,
+		Name:                    types.StringValue(s.Name),
+		ConnectionPoolerEnabled: types.BoolValue(s.ServiceSpec.PoolerEnabled),
+		RegionCode:              types.StringValue(s.RegionCode),
+		Hostname:                types.StringNull(),
+		Port:                    types.Int64Null(),
+		PoolerHostname:          types.StringNull(),
+		PoolerPort:              types.Int64Null(),
+		Timeouts:                state.Timeouts,
+	}
+	if s.ForkSpec != nil {
+		model.PrimaryServiceID = types.StringValue(s.ForkSpec.ServiceID)
+	}
+	if len(s.Resources) > 0 {
+		spec := s.Resources[0].Spec
+		model.Nodes = types.Int64Value(spec.ReplicaCount + 1)
+		model.MilliCPU = types.Int64Value(spec.MilliCPU)
+		model.MemoryGB = types.Int64Value(spec.MemoryGB)
+	}
+
+	endpoints := []attr.Value{}
+	if s.Endpoints != nil {
+		if s.Endpoints.Primary != nil && s.Endpoints.Primary.Host != "" {
+			model.Hostname = types.StringValue(s.Endpoints.Primary.Host)
+			model.Port = types.Int64Value(int64(s.Endpoints.Primary.Port))
+		}
+		if s.ServiceSpec.PoolerEnabled && s.Endpoints.Pooler != nil && s.Endpoints.Pooler.Host != "" {
+			model.PoolerHostname = types.StringValue(s.Endpoints.Pooler.Host)
+			model.PoolerPort = types.Int64Value(int64(s.Endpoints.Pooler.Port))
+		}
+		for _, node := range s.Endpoints.Nodes {
+			endpoint, d := types.ObjectValue(replicaEndpointAttrTypes, map[string]attr.Value{
+				"name": types.StringValue(node.Name),
+				"host": types.StringValue(node.Host),
+				"port": types.Int64Value(int64(node.Port)),
+			})
+			diags.Append(d...)
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: replicaEndpointAttrTypes}, endpoints)
+	diags.Append(d...)
+	model.Endpoints = list
+	return model
+}
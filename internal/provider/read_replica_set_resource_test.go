@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func readReplicaSetConfig(nodes int, pooler bool) string {
+	return providerConfig + fmt.Sprintf(`
+resource "timescale_service" "primary" {
+  name        = "tf-acc-test-replica-set-primary"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+}
+
+resource "timescale_read_replica_set" "replicas" {
+  primary_service_id        = timescale_service.primary.id
+  name                      = "tf-acc-test-replica-set"
+  nodes                     = %d
+  connection_pooler_enabled = %t
+}
+`, nodes, pooler)
+}
+
+func TestAcc_ReadReplicaSetResource(t *testing.T) {
+	resourceName := "timescale_read_replica_set.replicas"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: readReplicaSetConfig(1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_service_id", "timescale_service.primary", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "region_code", "timescale_service.primary", "region_code"),
+					resource.TestCheckResourceAttr(resourceName, "nodes", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckNoResourceAttr(resourceName, "pooler_hostname"),
+				),
+			},
+			{
+				Config: readReplicaSetConfig(2, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "nodes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pooler_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "pooler_hostname"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
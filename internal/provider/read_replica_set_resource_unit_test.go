@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestReadReplicaSetToResource(t *testing.T) {
+	s := newTestService()
+	s.ForkSpec = &tsClient.ForkSpec{ServiceID: "primary", IsStandby: true}
+	s.Resources[0].Spec.ReplicaCount = 1
+	s.Resources[0].Spec.MilliCPU = 1000
+	s.Resources[0].Spec.MemoryGB = 4
+	s.Endpoints = &tsClient.ServiceEndpoints{
+		Primary: &tsClient.EndpointAddress{Host: "replicas.example.com", Port: 5432},
+		Nodes: []tsClient.NodeEndpoint{
+			{Name: "node-0", Host: "node-0.example.com", Port: 5432},
+			{Name: "node-1", Host: "node-1.example.com", Port: 5432},
+		},
+	}
+
+	var diags diag.Diagnostics
+	model := readReplicaSetToResource(&diags, s, readReplicaSetResourceModel{})
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.Equal(t, "primary", model.PrimaryServiceID.ValueString())
+	require.Equal(t, int64(2), model.Nodes.ValueInt64())
+	require.Equal(t, int64(1000), model.MilliCPU.ValueInt64())
+	require.Equal(t, "replicas.example.com", model.Hostname.ValueString())
+	require.True(t, model.PoolerHostname.IsNull())
+	require.Len(t, model.Endpoints.Elements(), 2)
+}
+
+func TestReadReplicaSetMoveState(t *testing.T) {
+	ctx := context.Background()
+	r := &readReplicaSetResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	mover := r.MoveState(ctx)[0].StateMover
+
+	move := func(sourceType, rawJSON string) *resource.MoveStateResponse {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover(ctx, resource.MoveStateRequest{
+			SourceTypeName: sourceType,
+			SourceRawState: &tfprotov6.RawState{JSON: []byte(rawJSON)},
+		}, resp)
+		return resp
+	}
+
+	resp := move("timescale_service", `{"id":"replica","name":"r","read_replica_source":"primary","read_replica_nodes":3,"milli_cpu":1000,"memory_gb":4,"connection_pooler_enabled":true,"region_code":"us-east-1"}`)
+	require.False(t, resp.Diagnostics.HasError(), "diags: %v", resp.Diagnostics)
+	var model readReplicaSetResourceModel
+	require.False(t, resp.TargetState.Get(ctx, &model).HasError())
+	require.Equal(t, "primary", model.PrimaryServiceID.ValueString())
+	require.Equal(t, int64(3), model.Nodes.ValueInt64())
+	require.Equal(t, types.BoolValue(true), model.ConnectionPoolerEnabled)
+
+	resp = move("timescale_service", `{"id":"svc","name":"s","milli_cpu":500,"memory_gb":2}`)
+	require.True(t, resp.Diagnostics.HasError())
+
+	// Other resource types are left to other movers.
+	resp = move("timescale_vpcs", `{}`)
+	require.False(t, resp.Diagnostics.HasError())
+	require.True(t, resp.TargetState.Raw.IsNull())
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+const (
+	defaultScaleUpThreshold   = 80
+	defaultScaleDownThreshold = 30
+
+	errSetAutoscaling = "Failed to set autoscaling"
+)
+
+var autoscalingAttrTypes = map[string]attr.Type{
+	"min_milli_cpu":        types.Int64Type,
+	"min_memory_gb":        types.Int64Type,
+	"max_milli_cpu":        types.Int64Type,
+	"max_memory_gb":        types.Int64Type,
+	"scale_up_threshold":   types.Int64Type,
+	"scale_down_threshold": types.Int64Type,
+}
+
+// autoscalingModel maps the autoscaling nested attribute.
+type autoscalingModel struct {
+	MinMilliCPU        types.Int64 `tfsdk:"min_milli_cpu"`
+	MinMemoryGB        types.Int64 `tfsdk:"min_memory_gb"`
+	MaxMilliCPU        types.Int64 `tfsdk:"max_milli_cpu"`
+	MaxMemoryGB        types.Int64 `tfsdk:"max_memory_gb"`
+	ScaleUpThreshold   types.Int64 `tfsdk:"scale_up_threshold"`
+	ScaleDownThreshold types.Int64 `tfsdk:"scale_down_threshold"`
+}
+
+func autoscalingFromModel(ctx context.Context, obj types.Object) (tsClient.AutoscalingSettings, diag.Diagnostics) {
+	var m autoscalingModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	return tsClient.AutoscalingSettings{
+		Enabled:            true,
+		MinMilliCPU:        m.MinMilliCPU.ValueInt64(),
+		MinMemoryGB:        m.MinMemoryGB.ValueInt64(),
+		MaxMilliCPU:        m.MaxMilliCPU.ValueInt64(),
+		MaxMemoryGB:        m.MaxMemoryGB.ValueInt64(),
+		ScaleUpThreshold:   m.ScaleUpThreshold.ValueInt64(),
+		ScaleDownThreshold: m.ScaleDownThreshold.ValueInt64(),
+	}, diags
+}
+
+// autoscalingToModel returns a null object when autoscaling is disabled.
+func autoscalingToModel(s *tsClient.AutoscalingSettings) types.Object {
+	if s == nil || !s.Enabled {
+		return types.ObjectNull(autoscalingAttrTypes)
+	}
+	return types.ObjectValueMust(autoscalingAttrTypes, map[string]attr.Value{
+		"min_milli_cpu":        types.Int64Value(s.MinMilliCPU),
+		"min_memory_gb":        types.Int64Value(s.MinMemoryGB),
+		"max_milli_cpu":        types.Int64Value(s.MaxMilliCPU),
+		"max_memory_gb":        types.Int64Value(s.MaxMemoryGB),
+		"scale_up_threshold":   types.Int64Value(s.ScaleUpThreshold),
+		"scale_down_threshold": types.Int64Value(s.ScaleDownThreshold),
+	})
+}
+
+// validateAutoscaling checks that the bounds and thresholds are ordered and
+// that the baseline compute size lies within the bounds.
+func validateAutoscaling(settings tsClient.AutoscalingSettings, milliCPU, memoryGB types.Int64) error {
+	if settings.MinMilliCPU > settings.MaxMilliCPU || settings.MinMemoryGB > settings.MaxMemoryGB {
+		return fmt.Errorf("the minimum size (%d milli CPU, %d GB) must not be larger than the maximum size (%d milli CPU, %d GB)",
+			settings.MinMilliCPU, settings.MinMemoryGB, settings.MaxMilliCPU, settings.MaxMemoryGB)
+	}
+	if settings.ScaleDownThreshold >= settings.ScaleUpThreshold {
+		return fmt.Errorf("scale_down_threshold (%d) must be lower than scale_up_threshold (%d)", settings.ScaleDownThreshold, settings.ScaleUpThreshold)
+	}
+	if milliCPU.IsUnknown() || memoryGB.IsUnknown() {
+		return nil
+	}
+	if m := milliCPU.ValueInt64(); m < settings.MinMilliCPU || m > settings.MaxMilliCPU {
+		return fmt.Errorf("milli_cpu (%d) must be between min_milli_cpu (%d) and max_milli_cpu (%d)", m, settings.MinMilliCPU, settings.MaxMilliCPU)
+	}
+	if m := memoryGB.ValueInt64(); m < settings.MinMemoryGB || m > settings.MaxMemoryGB {
+		return fmt.Errorf("memory_gb (%d) must be between min_memory_gb (%d) and max_memory_gb (%d)", m, settings.MinMemoryGB, settings.MaxMemoryGB)
+	}
+	return nil
+}
+
+// validateAutoscalingPlan validates the planned autoscaling block once all of
+// its values are known.
+func validateAutoscalingPlan(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var autoscaling types.Object
+	var milliCPU, memoryGB types.Int64
+	diags := plan.GetAttribute(ctx, path.Root("autoscaling"), &autoscaling)
+	diags.Append(plan.GetAttribute(ctx, path.Root("milli_cpu"), &milliCPU)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("memory_gb"), &memoryGB)...)
+	if diags.HasError() || autoscaling.IsNull() || autoscaling.IsUnknown() {
+		return diags
+	}
+	for _, v := range autoscaling.Attributes() {
+		if v.IsUnknown() {
+			return diags
+		}
+	}
+	settings, d := autoscalingFromModel(ctx, autoscaling)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if err := validateAutoscaling(settings, milliCPU, memoryGB); err != nil {
+		diags.AddAttributeError(path.Root("autoscaling"), ErrInvalidAttribute, err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+const (
+	errComputeSizeFormat   = "expected a compute size of the form <cpu>cpu-<memory>gb, such as 2cpu-8gb or 0.5cpu-2gb, got: %q"
+	errComputeSizeConflict = "%s = %d does not match compute_size %q, remove it or set it to %d"
+	// computeSizeSuggestions is the number of nearby sizes suggested when a
+	// size is not available.
+	computeSizeSuggestions = 3
+)
+
+var computeSizeRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)cpu-([0-9]+)gb$`)
+
+// computeSize is a CPU and memory combination a service can be created with.
+type computeSize struct {
+	MilliCPU int64
+	MemoryGB int64
+}
+
+// String formats the size the way compute_size is configured, such as 2cpu-8gb.
+func (s computeSize) String() string {
+	cpu := strconv.FormatFloat(float64(s.MilliCPU)/1000, 'f', -1, 64)
+	return fmt.Sprintf("%scpu-%dgb", cpu, s.MemoryGB)
+}
+
+func parseComputeSize(v string) (computeSize, error) {
+	m := computeSizeRegex.FindStringSubmatch(v)
+	if m == nil {
+		return computeSize{}, fmt.Errorf(errComputeSizeFormat, v)
+	}
+	cpu, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return computeSize{}, fmt.Errorf(errComputeSizeFormat, v)
+	}
+	memory, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return computeSize{}, fmt.Errorf(errComputeSizeFormat, v)
+	}
+	milliCPU := cpu * 1000
+	if milliCPU != math.Trunc(milliCPU) || milliCPU <= 0 || memory <= 0 {
+		return computeSize{}, fmt.Errorf(errComputeSizeFormat, v)
+	}
+	return computeSize{MilliCPU: int64(milliCPU), MemoryGB: memory}, nil
+}
+
+// computeSizeValue returns compute_size for the size. The prior value is kept
+// when it spells the same size differently, such as 0.50cpu-2gb, so that the
+// configured value does not change after apply.
+func computeSizeValue(size computeSize, prior types.String) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if parsed, err := parseComputeSize(prior.ValueString()); err == nil && parsed == size {
+			return prior
+		}
+	}
+	return types.StringValue(size.String())
+}
+
+// staticComputeSizes are the sizes known at release time, used when the
+// product catalog cannot be loaded.
+func staticComputeSizes() []computeSize {
+	sizes := make([]computeSize, 0, len(milliCPUSizes))
+	for i, milliCPU := range milliCPUSizes {
+		sizes = append(sizes, computeSize{MilliCPU: milliCPU, MemoryGB: memorySizes[i]})
+	}
+	return sizes
+}
+
+// computeSizesFromProducts returns the sorted sizes the products offer in the
+// region, or in any region if region is empty. Vanilla PG products and the
+// retired 0.25 CPU plans are skipped, as in the timescale_products data source.
+func computeSizesFromProducts(products []*tsClient.Product, region string) []computeSize {
+	seen := make(map[computeSize]bool)
+	var sizes []computeSize
+	for _, product := range products {
+		if strings.Contains(product.ID, "product_pg") {
+			continue
+		}
+		for _, plan := range product.Plans {
+			if plan.MilliCPU == 250 || (region != "" && plan.RegionCode != region) {
+				continue
+			}
+			size := computeSize{MilliCPU: plan.MilliCPU, MemoryGB: plan.MemoryGB}
+			if !seen[size] {
+				seen[size] = true
+				sizes = append(sizes, size)
+			}
+		}
+	}
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].MilliCPU != sizes[j].MilliCPU {
+			return sizes[i].MilliCPU < sizes[j].MilliCPU
+		}
+		return sizes[i].MemoryGB < sizes[j].MemoryGB
+	})
+	return sizes
+}
+
+// nearestComputeSizes returns up to n sizes closest to want, comparing CPU
+// and memory on a logarithmic scale so that doubling either counts the same.
+func nearestComputeSizes(sizes []computeSize, want computeSize, n int) []computeSize {
+	distance := func(s computeSize) float64 {
+		return math.Abs(math.Log2(float64(s.MilliCPU)/float64(want.MilliCPU))) +
+			math.Abs(math.Log2(float64(s.MemoryGB)/float64(want.MemoryGB)))
+	}
+	nearest := append([]computeSize(nil), sizes...)
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return distance(nearest[i]) < distance(nearest[j])
+	})
+	if len(nearest) > n {
+		nearest = nearest[:n]
+	}
+	return nearest
+}
+
+// checkComputeSize returns an error suggesting the nearest sizes if want is
+// not one of sizes.
+func checkComputeSize(sizes []computeSize, want computeSize, region string) error {
+	for _, s := range sizes {
+		if s == want {
+			return nil
+		}
+	}
+	where := "any region"
+	if region != "" {
+		where = "region " + region
+	}
+	names := make([]string, 0, computeSizeSuggestions)
+	for _, s := range nearestComputeSizes(sizes, want, computeSizeSuggestions) {
+		names = append(names, s.String())
+	}
+	return fmt.Errorf("compute size %s is not available in %s, the closest available sizes are: %s", want, where, strings.Join(names, ", "))
+}
+
+// productCatalog loads the product plans once per provider run. Failed loads
+// are not cached, so a transient API error is retried by the next caller.
+type productCatalog struct {
+	client *tsClient.Client
+
+	mu       sync.Mutex
+	loaded   bool
+	products []*tsClient.Product
+}
+
+func newProductCatalog(client *tsClient.Client) *productCatalog {
+	return &productCatalog{client: client}
+}
+
+// computeSizes returns the sizes offered in the region. An error means the
+// catalog could not be loaded and the caller should fall back to
+// staticComputeSizes.
+func (c *productCatalog) computeSizes(ctx context.Context, region string) ([]computeSize, error) {
+	products, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return computeSizesFromProducts(products, region), nil
+}
+
+func (c *productCatalog) load(ctx context.Context) ([]*tsClient.Product, error) {
+	if c == nil || c.client == nil {
+		return nil, fmt.Errorf("no API client configured")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return c.products, nil
+	}
+	products, err := c.client.GetProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.products, c.loaded = products, true
+	return c.products, nil
+}
+
+// planComputeSize resolves compute_size into milli_cpu and memory_gb, or
+// derives it from them, and validates new sizes against the product catalog
+// of the region of the service.
+func (r *serviceResource) planComputeSize(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configSize types.String
+	var configMilliCPU, configMemoryGB, planMilliCPU, planMemoryGB types.Int64
+	var region types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("compute_size"), &configSize)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("milli_cpu"), &configMilliCPU)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("memory_gb"), &configMemoryGB)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("milli_cpu"), &planMilliCPU)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("memory_gb"), &planMemoryGB)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("region_code"), &region)...)
+	if resp.Diagnostics.HasError() || configSize.IsUnknown() {
+		return
+	}
+
+	var want computeSize
+	attrPath := path.Root("milli_cpu")
+	if !configSize.IsNull() {
+		attrPath = path.Root("compute_size")
+		size, err := parseComputeSize(configSize.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attrPath, ErrInvalidAttribute, err.Error())
+			return
+		}
+		if !configMilliCPU.IsNull() && !configMilliCPU.IsUnknown() && configMilliCPU.ValueInt64() != size.MilliCPU {
+			resp.Diagnostics.AddAttributeError(path.Root("milli_cpu"), ErrInvalidAttribute, fmt.Sprintf(errComputeSizeConflict, "milli_cpu", configMilliCPU.ValueInt64(), configSize.ValueString(), size.MilliCPU))
+		}
+		if !configMemoryGB.IsNull() && !configMemoryGB.IsUnknown() && configMemoryGB.ValueInt64() != size.MemoryGB {
+			resp.Diagnostics.AddAttributeError(path.Root("memory_gb"), ErrInvalidAttribute, fmt.Sprintf(errComputeSizeConflict, "memory_gb", configMemoryGB.ValueInt64(), configSize.ValueString(), size.MemoryGB))
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		want = size
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("milli_cpu"), types.Int64Value(size.MilliCPU))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("memory_gb"), types.Int64Value(size.MemoryGB))...)
+	} else {
+		if planMilliCPU.IsUnknown() || planMemoryGB.IsUnknown() || planMilliCPU.IsNull() || planMemoryGB.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("compute_size"), types.StringUnknown())...)
+			return
+		}
+		want = computeSize{MilliCPU: planMilliCPU.ValueInt64(), MemoryGB: planMemoryGB.ValueInt64()}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("compute_size"), types.StringValue(want.String()))...)
+	}
+
+	// Existing sizes are not checked again, a service keeps its size even
+	// once it is no longer offered.
+	if !req.State.Raw.IsNull() {
+		var stateMilliCPU, stateMemoryGB types.Int64
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("milli_cpu"), &stateMilliCPU)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("memory_gb"), &stateMemoryGB)...)
+		if stateMilliCPU.ValueInt64() == want.MilliCPU && stateMemoryGB.ValueInt64() == want.MemoryGB {
+			return
+		}
+	}
+
+	regionCode := ""
+	if !region.IsUnknown() {
+		regionCode = region.ValueString()
+	}
+	sizes, err := r.catalog.computeSizes(ctx, regionCode)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(attrPath, "Unable to Load Product Catalog",
+			fmt.Sprintf("The compute size is validated against the sizes known to this provider version instead: %s", err))
+	}
+	if err != nil || len(sizes) == 0 {
+		// Unknown regions are reported by the API on create.
+		sizes, regionCode = staticComputeSizes(), ""
+	}
+	if err := checkComputeSize(sizes, want, regionCode); err != nil {
+		resp.Diagnostics.AddAttributeError(attrPath, ErrInvalidAttribute, err.Error())
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestParseComputeSize(t *testing.T) {
+	for in, want := range map[string]computeSize{
+		"2cpu-8gb":    {MilliCPU: 2000, MemoryGB: 8},
+		"0.5cpu-2gb":  {MilliCPU: 500, MemoryGB: 2},
+		"64cpu-256gb": {MilliCPU: 64000, MemoryGB: 256},
+		"1.25cpu-5gb": {MilliCPU: 1250, MemoryGB: 5},
+		"0.50cpu-2gb": {MilliCPU: 500, MemoryGB: 2},
+		"02cpu-8gb":   {MilliCPU: 2000, MemoryGB: 8},
+	} {
+		got, err := parseComputeSize(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "2cpu", "2-8", "2cpu-8", "0cpu-2gb", "0.0001cpu-2gb", "2cpu-0gb", "2cpu-8.5gb", " 4cpu-16gb", "4CPU-16GB"} {
+		_, err := parseComputeSize(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestComputeSizeValue(t *testing.T) {
+	size := computeSize{MilliCPU: 500, MemoryGB: 2}
+	for prior, want := range map[string]string{
+		"0.5cpu-2gb":  "0.5cpu-2gb",
+		"0.50cpu-2gb": "0.50cpu-2gb",
+		"00.5cpu-2gb": "00.5cpu-2gb",
+		"2cpu-8gb":    "0.5cpu-2gb",
+	} {
+		require.Equal(t, want, computeSizeValue(size, types.StringValue(prior)).ValueString(), prior)
+	}
+	require.Equal(t, "02cpu-8gb", computeSizeValue(computeSize{MilliCPU: 2000, MemoryGB: 8}, types.StringValue("02cpu-8gb")).ValueString())
+	require.Equal(t, "0.5cpu-2gb", computeSizeValue(size, types.StringNull()).ValueString())
+	require.Equal(t, "0.5cpu-2gb", computeSizeValue(size, types.StringUnknown()).ValueString())
+}
+
+func TestServiceToResource_ComputeSize(t *testing.T) {
+	s := newTestService()
+	s.Resources[0].Spec.MilliCPU = 2000
+	s.Resources[0].Spec.MemoryGB = 8
+	for prior, want := range map[string]string{
+		"02cpu-8gb":   "02cpu-8gb",
+		"2.0cpu-8gb":  "2.0cpu-8gb",
+		"0.50cpu-2gb": "2cpu-8gb",
+	} {
+		model := serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{ComputeSize: types.StringValue(prior)}, nil)
+		require.Equal(t, want, model.ComputeSize.ValueString(), prior)
+	}
+}
+
+func TestComputeSize_String(t *testing.T) {
+	require.Equal(t, "2cpu-8gb", computeSize{MilliCPU: 2000, MemoryGB: 8}.String())
+	require.Equal(t, "0.5cpu-2gb", computeSize{MilliCPU: 500, MemoryGB: 2}.String())
+	for _, s := range staticComputeSizes() {
+		parsed, err := parseComputeSize(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, parsed)
+	}
+}
+
+func TestComputeSizesFromProducts(t *testing.T) {
+	products := []*tsClient.Product{
+		{ID: "product_ts", Plans: []*tsClient.Plan{
+			{RegionCode: "us-east-1", MilliCPU: 250, MemoryGB: 1},
+			{RegionCode: "us-east-1", MilliCPU: 2000, MemoryGB: 8},
+			{RegionCode: "us-east-1", MilliCPU: 500, MemoryGB: 2},
+			{RegionCode: "eu-central-1", MilliCPU: 500, MemoryGB: 2},
+			{RegionCode: "eu-central-1", MilliCPU: 96000, MemoryGB: 384},
+		}},
+		{ID: "product_ts_storage", Plans: []*tsClient.Plan{
+			{RegionCode: "us-east-1", MilliCPU: 2000, MemoryGB: 8},
+		}},
+		{ID: "product_pg", Plans: []*tsClient.Plan{
+			{RegionCode: "us-east-1", MilliCPU: 1000, MemoryGB: 4},
+		}},
+	}
+	require.Equal(t, []computeSize{{500, 2}, {2000, 8}}, computeSizesFromProducts(products, "us-east-1"))
+	require.Equal(t, []computeSize{{500, 2}, {96000, 384}}, computeSizesFromProducts(products, "eu-central-1"))
+	require.Equal(t, []computeSize{{500, 2}, {2000, 8}, {96000, 384}}, computeSizesFromProducts(products, ""))
+	require.Empty(t, computeSizesFromProducts(products, "ap-south-1"))
+}
+
+func TestCheckComputeSize(t *testing.T) {
+	sizes := staticComputeSizes()
+	require.NoError(t, checkComputeSize(sizes, computeSize{MilliCPU: 2000, MemoryGB: 8}, "us-east-1"))
+
+	err := checkComputeSize(sizes, computeSize{MilliCPU: 3000, MemoryGB: 12}, "us-east-1")
+	require.EqualError(t, err, "compute size 3cpu-12gb is not available in region us-east-1, the closest available sizes are: 4cpu-16gb, 2cpu-8gb, 8cpu-32gb")
+
+	err = checkComputeSize(sizes, computeSize{MilliCPU: 2000, MemoryGB: 2}, "")
+	require.ErrorContains(t, err, "not available in any region")
+	require.ErrorContains(t, err, "0.5cpu-2gb")
+	require.ErrorContains(t, err, "2cpu-8gb")
+}
+
+func TestProductCatalog_WithoutClient(t *testing.T) {
+	var catalog *productCatalog
+	_, err := catalog.computeSizes(t.Context(), "us-east-1")
+	require.Error(t, err)
+}
+
+func TestProductCatalog_RetriesFailedLoads(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			_, _ = fmt.Fprint(w, `{"errors":[{"message":"temporarily unavailable"}]}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data":{"orbProducts":[{"id":"p","plans":[{"regionCode":"us-east-1","milliCPU":2000,"memoryGB":8}]}]}}`)
+	}))
+	defer srv.Close()
+	t.Setenv("TIMESCALE_DEV_URL", srv.URL)
+	catalog := newProductCatalog(tsClient.NewClient("token", "proj", "test", "1.0.0"))
+
+	_, err := catalog.computeSizes(t.Context(), "us-east-1")
+	require.Error(t, err)
+
+	sizes, err := catalog.computeSizes(t.Context(), "us-east-1")
+	require.NoError(t, err)
+	require.Equal(t, []computeSize{{MilliCPU: 2000, MemoryGB: 8}}, sizes)
+
+	_, err = catalog.computeSizes(t.Context(), "us-east-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, calls, "successful loads are cached")
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// defaultDBName is the database services are created with.
+const defaultDBName = "tsdb"
+
+const (
+	endpointPublic  = "public"
+	endpointPrivate = "private"
+)
+
+var endpointAttrTypes = map[string]attr.Type{
+	"role":       types.StringType,
+	"host":       types.StringType,
+	"port":       types.Int64Type,
+	"visibility": types.StringType,
+}
+
+// serviceEndpoint is one address a service can be reached at.
+type serviceEndpoint struct {
+	role       string
+	host       string
+	port       int64
+	visibility string
+}
+
+// connectionURI builds a postgres:// URI that requires TLS. The password is
+// left out when empty.
+func connectionURI(username, password, host string, port int64, database string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.User(username),
+		Host:     net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+		Path:     "/" + database,
+		RawQuery: "sslmode=require",
+	}
+	if password != "" {
+		u.User = url.UserPassword(username, password)
+	}
+	return u.String()
+}
+
+// serviceDatabase returns the default database of a service.
+func serviceDatabase(s *tsClient.Service) string {
+	if s.ServiceSpec.DefaultDBName != "" {
+		return s.ServiceSpec.DefaultDBName
+	}
+	return defaultDBName
+}
+
+// endpointURI returns the URI of an endpoint of the service, or null when the
+// endpoint does not exist.
+func endpointURI(s *tsClient.Service, password string, host types.String, port types.Int64) types.String {
+	if host.IsNull() || port.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(connectionURI(s.ServiceSpec.Username, password, host.ValueString(), port.ValueInt64(), serviceDatabase(s)))
+}
+
+// listServiceEndpoints lists the endpoints of a service: the private endpoint
+// in its VPC first, then the ones reported by the API. The API reports the
+// private endpoint as primary once the service is attached to a VPC, so the
+// public addresses of the spec are added as well. An address is only listed
+// once, with its first role.
+func listServiceEndpoints(s *tsClient.Service) []serviceEndpoint {
+	var endpoints []serviceEndpoint
+	seen := map[string]bool{}
+	add := func(role, host string, port int64, visibility string) {
+		address := net.JoinHostPort(host, strconv.FormatInt(port, 10))
+		if host == "" || seen[address] {
+			return
+		}
+		seen[address] = true
+		endpoints = append(endpoints, serviceEndpoint{role: role, host: host, port: port, visibility: visibility})
+	}
+
+	public := map[string]bool{s.ServiceSpec.Hostname: true, s.ServiceSpec.PoolerHostname: true}
+	inVPC := s.VPCEndpoint != nil
+	visibility := func(host string) string {
+		if inVPC && (host == s.VPCEndpoint.Host || !public[host]) {
+			return endpointPrivate
+		}
+		return endpointPublic
+	}
+	if inVPC {
+		add("primary", s.VPCEndpoint.Host, s.VPCEndpoint.Port, endpointPrivate)
+	}
+	if e := s.Endpoints; e != nil {
+		if e.Primary != nil {
+			add("primary", e.Primary.Host, int64(e.Primary.Port), visibility(e.Primary.Host))
+		}
+		if e.Replica != nil && len(s.Resources) > 0 && s.Resources[0].Spec.ReplicaCount > 0 {
+			add("replica", e.Replica.Host, int64(e.Replica.Port), visibility(e.Replica.Host))
+		}
+		if e.Pooler != nil && s.ServiceSpec.PoolerEnabled {
+			add("pooler", e.Pooler.Host, int64(e.Pooler.Port), visibility(e.Pooler.Host))
+		}
+	}
+	add("primary", s.ServiceSpec.Hostname, s.ServiceSpec.Port, endpointPublic)
+	if s.ServiceSpec.PoolerEnabled {
+		add("pooler", s.ServiceSpec.PoolerHostname, s.ServiceSpec.PoolerPort, endpointPublic)
+	}
+	return endpoints
+}
+
+// endpointsToList converts endpoints to the value of the endpoints attribute.
+func endpointsToList(endpoints []serviceEndpoint) types.List {
+	elems := make([]attr.Value, 0, len(endpoints))
+	for _, e := range endpoints {
+		elems = append(elems, types.ObjectValueMust(endpointAttrTypes, map[string]attr.Value{
+			"role":       types.StringValue(e.role),
+			"host":       types.StringValue(e.host),
+			"port":       types.Int64Value(e.port),
+			"visibility": types.StringValue(e.visibility),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: endpointAttrTypes}, elems)
+}
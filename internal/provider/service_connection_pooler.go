@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+const (
+	errSetPoolerSettings   = "Failed to configure connection pooler"
+	errPoolerWithoutPooler = "connection_pooler can only be set when connection_pooler_enabled is true"
+
+	// maxClientConnectionsPerCPU is how many client connections the pooler
+	// accepts per CPU of the service.
+	maxClientConnectionsPerCPU = 5000
+)
+
+var (
+	poolModes = []string{"session", "transaction"}
+
+	// maxConnectionsByMemory is the Postgres max_connections of each memory
+	// size. The pools of all databases share these server connections.
+	maxConnectionsByMemory = map[int64]int64{
+		2: 100, 4: 200, 8: 400, 16: 800, 32: 1600, 64: 3200, 128: 5000, 192: 5000, 256: 5000,
+	}
+
+	poolerDatabaseAttrTypes = map[string]attr.Type{
+		"pool_mode": types.StringType,
+		"pool_size": types.Int64Type,
+	}
+	connectionPoolerAttrTypes = map[string]attr.Type{
+		"pool_mode":              types.StringType,
+		"default_pool_size":      types.Int64Type,
+		"max_client_connections": types.Int64Type,
+		"databases":              types.MapType{ElemType: types.ObjectType{AttrTypes: poolerDatabaseAttrTypes}},
+	}
+)
+
+// connectionPoolerModel maps the connection_pooler nested attribute.
+type connectionPoolerModel struct {
+	PoolMode             types.String `tfsdk:"pool_mode"`
+	DefaultPoolSize      types.Int64  `tfsdk:"default_pool_size"`
+	MaxClientConnections types.Int64  `tfsdk:"max_client_connections"`
+	Databases            types.Map    `tfsdk:"databases"`
+}
+
+type poolerDatabaseModel struct {
+	PoolMode types.String `tfsdk:"pool_mode"`
+	PoolSize types.Int64  `tfsdk:"pool_size"`
+}
+
+// poolerSettingsFromModel converts the planned settings. Unknown values are
+// left out so that the pooler keeps its current setting.
+func poolerSettingsFromModel(ctx context.Context, obj types.Object) (tsClient.PoolerSettings, diag.Diagnostics) {
+	var m connectionPoolerModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	settings := tsClient.PoolerSettings{
+		PoolMode:             strings.ToUpper(m.PoolMode.ValueString()),
+		DefaultPoolSize:      m.DefaultPoolSize.ValueInt64(),
+		MaxClientConnections: m.MaxClientConnections.ValueInt64(),
+		Databases:            []tsClient.PoolerDatabaseSettings{},
+	}
+	var databases map[string]poolerDatabaseModel
+	if !m.Databases.IsNull() && !m.Databases.IsUnknown() {
+		diags.Append(m.Databases.ElementsAs(ctx, &databases, false)...)
+	}
+	names := make([]string, 0, len(databases))
+	for name := range databases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		settings.Databases = append(settings.Databases, tsClient.PoolerDatabaseSettings{
+			Name:     name,
+			PoolMode: strings.ToUpper(databases[name].PoolMode.ValueString()),
+			PoolSize: databases[name].PoolSize.ValueInt64(),
+		})
+	}
+	return settings, diags
+}
+
+// poolerSettingsToModel returns a null object when the pooler is disabled.
+func poolerSettingsToModel(enabled bool, s *tsClient.PoolerSettings) types.Object {
+	if !enabled || s == nil {
+		return types.ObjectNull(connectionPoolerAttrTypes)
+	}
+	databases := types.MapNull(types.ObjectType{AttrTypes: poolerDatabaseAttrTypes})
+	if len(s.Databases) > 0 {
+		elems := make(map[string]attr.Value, len(s.Databases))
+		for _, d := range s.Databases {
+			poolMode, poolSize := types.StringNull(), types.Int64Null()
+			if d.PoolMode != "" {
+				poolMode = types.StringValue(strings.ToLower(d.PoolMode))
+			}
+			if d.PoolSize != 0 {
+				poolSize = types.Int64Value(d.PoolSize)
+			}
+			elems[d.Name] = types.ObjectValueMust(poolerDatabaseAttrTypes, map[string]attr.Value{
+				"pool_mode": poolMode,
+				"pool_size": poolSize,
+			})
+		}
+		databases = types.MapValueMust(types.ObjectType{AttrTypes: poolerDatabaseAttrTypes}, elems)
+	}
+	return types.ObjectValueMust(connectionPoolerAttrTypes, map[string]attr.Value{
+		"pool_mode":              types.StringValue(strings.ToLower(s.PoolMode)),
+		"default_pool_size":      types.Int64Value(s.DefaultPoolSize),
+		"max_client_connections": types.Int64Value(s.MaxClientConnections),
+		"databases":              databases,
+	})
+}
+
+// validatePoolerSettings checks the pool sizes against the connections the
+// service accepts at the given size, and the client connections against its CPU.
+func validatePoolerSettings(settings tsClient.PoolerSettings, milliCPU, memoryGB int64) error {
+	if maxConnections, ok := maxConnectionsByMemory[memoryGB]; ok {
+		if settings.DefaultPoolSize > maxConnections {
+			return fmt.Errorf("default_pool_size (%d) exceeds the %d connections of a service with %d GB memory", settings.DefaultPoolSize, maxConnections, memoryGB)
+		}
+		for _, d := range settings.Databases {
+			if d.PoolSize > maxConnections {
+				return fmt.Errorf("pool_size of database %q (%d) exceeds the %d connections of a service with %d GB memory", d.Name, d.PoolSize, maxConnections, memoryGB)
+			}
+		}
+	}
+	if maxClients := milliCPU * maxClientConnectionsPerCPU / 1000; milliCPU > 0 && settings.MaxClientConnections > maxClients {
+		return fmt.Errorf("max_client_connections (%d) exceeds the %d client connections supported with %d milli CPU", settings.MaxClientConnections, maxClients, milliCPU)
+	}
+	return nil
+}
+
+// planConnectionPooler keeps connection_pooler in line with
+// connection_pooler_enabled and validates it against the smallest size the
+// service may run at: its autoscaling minimum, or its configured size.
+func (r *serviceResource) planConnectionPooler(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var enabled types.Bool
+	var configured, autoscaling types.Object
+	var milliCPU, memoryGB types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("connection_pooler_enabled"), &enabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_pooler"), &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("autoscaling"), &autoscaling)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("milli_cpu"), &milliCPU)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("memory_gb"), &memoryGB)...)
+	if resp.Diagnostics.HasError() || enabled.IsUnknown() {
+		return
+	}
+
+	if !enabled.ValueBool() {
+		if !configured.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("connection_pooler"), ErrInvalidAttribute, errPoolerWithoutPooler)
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connection_pooler"), types.ObjectNull(connectionPoolerAttrTypes))...)
+		return
+	}
+	if configured.IsNull() || configured.IsUnknown() {
+		return
+	}
+
+	settings, diags := poolerSettingsFromModel(ctx, configured)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !autoscaling.IsNull() && !autoscaling.IsUnknown() {
+		bounds, diags := autoscalingFromModel(ctx, autoscaling)
+		resp.Diagnostics.Append(diags...)
+		milliCPU, memoryGB = types.Int64Value(bounds.MinMilliCPU), types.Int64Value(bounds.MinMemoryGB)
+	}
+	if milliCPU.IsUnknown() || memoryGB.IsUnknown() {
+		return
+	}
+	if err := validatePoolerSettings(settings, milliCPU.ValueInt64(), memoryGB.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("connection_pooler"), ErrInvalidAttribute, err.Error())
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// hoursPerMonth is the average number of hours in a month, used to turn
+// hourly plan prices into monthly estimates.
+const hoursPerMonth = 730
+
+// hourlyPrice returns the price of one node of the given size in the region,
+// from the first product offering it. Vanilla PG products are skipped, as in
+// the timescale_products data source.
+func hourlyPrice(products []*tsClient.Product, region string, size computeSize) (float64, bool) {
+	for _, product := range products {
+		if strings.Contains(product.ID, "product_pg") {
+			continue
+		}
+		for _, plan := range product.Plans {
+			if plan.RegionCode == region && plan.MilliCPU == size.MilliCPU && plan.MemoryGB == size.MemoryGB {
+				return plan.Price, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// serviceNodeCount returns the number of compute nodes of the service: the
+// nodes of a read replica, or the primary and its HA replicas. It returns
+// false while the count is not known yet.
+func serviceNodeCount(m serviceResourceModel) (int64, bool) {
+	if m.ReadReplicaSource.ValueString() != "" && !m.Promote.ValueBool() {
+		if m.ReadReplicaNodes.IsUnknown() {
+			return 0, false
+		}
+		if m.ReadReplicaNodes.IsNull() {
+			return 1, true
+		}
+		return m.ReadReplicaNodes.ValueInt64(), true
+	}
+	if m.HAReplicas.IsUnknown() {
+		return 0, false
+	}
+	return 1 + m.HAReplicas.ValueInt64(), true
+}
+
+// estimateServiceCost returns the hourly and monthly compute cost of the
+// service. Both are unknown while an input is unknown, and null when the
+// catalog cannot be loaded or has no plan for the size in the region.
+func (r *serviceResource) estimateServiceCost(ctx context.Context, m serviceResourceModel) (hourly, monthly types.Float64) {
+	nodes, ok := serviceNodeCount(m)
+	if !ok || m.RegionCode.IsUnknown() || m.MilliCPU.IsUnknown() || m.MemoryGB.IsUnknown() {
+		return types.Float64Unknown(), types.Float64Unknown()
+	}
+	return estimateComputeCost(ctx, r.catalog, m.RegionCode.ValueString(), computeSize{MilliCPU: m.MilliCPU.ValueInt64(), MemoryGB: m.MemoryGB.ValueInt64()}, nodes)
+}
+
+// estimateComputeCost returns the hourly and monthly cost of nodes of the
+// given size in the region. Both are null when the catalog cannot be loaded or
+// has no plan for the size in the region.
+func estimateComputeCost(ctx context.Context, catalog *productCatalog, region string, size computeSize, nodes int64) (hourly, monthly types.Float64) {
+	products, err := catalog.load(ctx)
+	if err != nil {
+		return types.Float64Null(), types.Float64Null()
+	}
+	price, ok := hourlyPrice(products, region, size)
+	if !ok {
+		return types.Float64Null(), types.Float64Null()
+	}
+	hourlyCost := price * float64(nodes)
+	return types.Float64Value(roundCost(hourlyCost, 4)), types.Float64Value(roundCost(hourlyCost*hoursPerMonth, 2))
+}
+
+// applyEstimatedCost sets the cost estimates of the model after an apply. The
+// planned estimates are kept, unless they were unknown.
+func (r *serviceResource) applyEstimatedCost(ctx context.Context, m *serviceResourceModel, plan serviceResourceModel) {
+	m.EstimatedHourlyCost, m.EstimatedMonthlyCost = appliedCost(plan.EstimatedHourlyCost, plan.EstimatedMonthlyCost, func() (types.Float64, types.Float64) {
+		return r.estimateServiceCost(ctx, *m)
+	})
+}
+
+// refreshEstimatedCost sets the cost estimates of the model on read. If no
+// price is found, the estimates of the prior state are kept.
+func (r *serviceResource) refreshEstimatedCost(ctx context.Context, m *serviceResourceModel, state serviceResourceModel) {
+	hourly, monthly := r.estimateServiceCost(ctx, *m)
+	m.EstimatedHourlyCost, m.EstimatedMonthlyCost = refreshedCost(hourly, monthly, state.EstimatedHourlyCost, state.EstimatedMonthlyCost)
+}
+
+// planEstimatedCost plans the cost estimates from the planned size, region and
+// node count, so cost changes show up in the plan.
+func (r *serviceResource) planEstimatedCost(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	var plan serviceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hourly, monthly := r.estimateServiceCost(ctx, plan)
+	planCost(ctx, resp, plan.EstimatedHourlyCost, hourly, monthly)
+}
+
+// estimateReplicaSetCost returns the hourly and monthly compute cost of the
+// nodes of the read replica set, like estimateServiceCost.
+func (r *readReplicaSetResource) estimateReplicaSetCost(ctx context.Context, m readReplicaSetResourceModel) (hourly, monthly types.Float64) {
+	if m.Nodes.IsUnknown() || m.RegionCode.IsUnknown() || m.MilliCPU.IsUnknown() || m.MemoryGB.IsUnknown() {
+		return types.Float64Unknown(), types.Float64Unknown()
+	}
+	return estimateComputeCost(ctx, r.catalog, m.RegionCode.ValueString(), computeSize{MilliCPU: m.MilliCPU.ValueInt64(), MemoryGB: m.MemoryGB.ValueInt64()}, m.Nodes.ValueInt64())
+}
+
+// appliedCost returns the cost estimates to store after an apply: the planned
+// ones, or a new estimate when they were unknown at plan time.
+func appliedCost(plannedHourly, plannedMonthly types.Float64, estimate func() (types.Float64, types.Float64)) (hourly, monthly types.Float64) {
+	if !plannedHourly.IsUnknown() {
+		return plannedHourly, plannedMonthly
+	}
+	hourly, monthly = estimate()
+	if hourly.IsUnknown() {
+		return types.Float64Null(), types.Float64Null()
+	}
+	return hourly, monthly
+}
+
+// refreshedCost returns the cost estimates to store on read: the new
+// estimate, or the prior one when no price is found.
+func refreshedCost(hourly, monthly, priorHourly, priorMonthly types.Float64) (types.Float64, types.Float64) {
+	if hourly.IsNull() || hourly.IsUnknown() {
+		hourly, monthly = priorHourly, priorMonthly
+	}
+	if hourly.IsUnknown() {
+		return types.Float64Null(), types.Float64Null()
+	}
+	return hourly, monthly
+}
+
+// planCost sets the planned cost estimates. Without a price, a resource
+// without changes keeps its prior estimate.
+func planCost(ctx context.Context, resp *resource.ModifyPlanResponse, planned, hourly, monthly types.Float64) {
+	if hourly.IsNull() && !planned.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_hourly_cost"), hourly)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost"), monthly)...)
+}
+
+// planBudget checks the planned compute of the service against the budgets
+// of the provider.
+func (r *serviceResource) planBudget(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	if r.budget == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	var plan serviceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nodes, ok := serviceNodeCount(plan)
+	if !ok || plan.MilliCPU.IsUnknown() || plan.MemoryGB.IsUnknown() {
+		return
+	}
+	planned := plannedCompute{
+		id:          plan.ID.ValueString(),
+		name:        plan.Name.ValueString(),
+		region:      plan.RegionCode.ValueString(),
+		environment: plan.EnvironmentTag.ValueString(),
+		size:        computeSize{MilliCPU: plan.MilliCPU.ValueInt64(), MemoryGB: plan.MemoryGB.ValueInt64()},
+		nodes:       nodes,
+	}
+	if !plan.Promote.ValueBool() {
+		planned.parentID = plan.ReadReplicaSource.ValueString()
+	}
+	if planned.region == "" && planned.parentID == "" {
+		// The API picks the region of new services without region_code
+		return
+	}
+	resp.Diagnostics.Append(r.budget.check(ctx, r.client, r.catalog, planned)...)
+}
+
+func roundCost(v float64, decimals int) float64 {
+	p := math.Pow(10, float64(decimals))
+	return math.Round(v*p) / p
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// newTestCatalog returns a catalog that serves products without an API call.
+func newTestCatalog(products []*tsClient.Product) *productCatalog {
+	return &productCatalog{client: &tsClient.Client{}, products: products, loaded: true}
+}
+
+var testProducts = []*tsClient.Product{
+	{ID: "product_pg", Plans: []*tsClient.Plan{
+		{RegionCode: "us-east-1", MilliCPU: 2000, MemoryGB: 8, Price: 1},
+	}},
+	{ID: "product_ts", Plans: []*tsClient.Plan{
+		{RegionCode: "us-east-1", MilliCPU: 500, MemoryGB: 2, Price: 0.0274},
+		{RegionCode: "us-east-1", MilliCPU: 2000, MemoryGB: 8, Price: 0.4384},
+		{RegionCode: "eu-central-1", MilliCPU: 2000, MemoryGB: 8, Price: 0.5},
+	}},
+}
+
+func TestHourlyPrice(t *testing.T) {
+	price, ok := hourlyPrice(testProducts, "us-east-1", computeSize{MilliCPU: 2000, MemoryGB: 8})
+	require.True(t, ok)
+	require.Equal(t, 0.4384, price)
+
+	price, ok = hourlyPrice(testProducts, "eu-central-1", computeSize{MilliCPU: 2000, MemoryGB: 8})
+	require.True(t, ok)
+	require.Equal(t, 0.5, price)
+
+	_, ok = hourlyPrice(testProducts, "eu-central-1", computeSize{MilliCPU: 500, MemoryGB: 2})
+	require.False(t, ok)
+}
+
+func TestServiceNodeCount(t *testing.T) {
+	for name, tc := range map[string]struct {
+		model serviceResourceModel
+		want  int64
+		known bool
+	}{
+		"primary":                {serviceResourceModel{HAReplicas: types.Int64Value(0)}, 1, true},
+		"primary with HA":        {serviceResourceModel{HAReplicas: types.Int64Value(2)}, 3, true},
+		"unknown HA":             {serviceResourceModel{HAReplicas: types.Int64Unknown()}, 0, false},
+		"read replica":           {serviceResourceModel{ReadReplicaSource: types.StringValue("src")}, 1, true},
+		"read replica nodes":     {serviceResourceModel{ReadReplicaSource: types.StringValue("src"), ReadReplicaNodes: types.Int64Value(3)}, 3, true},
+		"unknown replica nodes":  {serviceResourceModel{ReadReplicaSource: types.StringValue("src"), ReadReplicaNodes: types.Int64Unknown()}, 0, false},
+		"promoted read replica":  {serviceResourceModel{ReadReplicaSource: types.StringValue("src"), Promote: types.BoolValue(true), HAReplicas: types.Int64Value(1)}, 2, true},
+		"read replica with null": {serviceResourceModel{ReadReplicaSource: types.StringValue("src"), ReadReplicaNodes: types.Int64Null()}, 1, true},
+	} {
+		got, known := serviceNodeCount(tc.model)
+		require.Equal(t, tc.known, known, name)
+		require.Equal(t, tc.want, got, name)
+	}
+}
+
+func TestEstimateServiceCost(t *testing.T) {
+	r := &serviceResource{catalog: newTestCatalog(testProducts)}
+	model := serviceResourceModel{
+		RegionCode: types.StringValue("us-east-1"),
+		MilliCPU:   types.Int64Value(2000),
+		MemoryGB:   types.Int64Value(8),
+		HAReplicas: types.Int64Value(1),
+	}
+	hourly, monthly := r.estimateServiceCost(t.Context(), model)
+	require.Equal(t, types.Float64Value(0.8768), hourly)
+	require.Equal(t, types.Float64Value(640.06), monthly)
+
+	unknown := model
+	unknown.RegionCode = types.StringUnknown()
+	hourly, monthly = r.estimateServiceCost(t.Context(), unknown)
+	require.True(t, hourly.IsUnknown())
+	require.True(t, monthly.IsUnknown())
+
+	noPlan := model
+	noPlan.MilliCPU, noPlan.MemoryGB = types.Int64Value(4000), types.Int64Value(16)
+	hourly, monthly = r.estimateServiceCost(t.Context(), noPlan)
+	require.True(t, hourly.IsNull())
+	require.True(t, monthly.IsNull())
+
+	// Without a catalog there is no estimate
+	hourly, _ = (&serviceResource{}).estimateServiceCost(t.Context(), model)
+	require.True(t, hourly.IsNull())
+}
+
+func TestRefreshEstimatedCost_KeepsStateWithoutPrice(t *testing.T) {
+	r := &serviceResource{}
+	state := serviceResourceModel{EstimatedHourlyCost: types.Float64Value(1), EstimatedMonthlyCost: types.Float64Value(730)}
+	model := serviceResourceModel{
+		RegionCode: types.StringValue("us-east-1"),
+		MilliCPU:   types.Int64Value(2000),
+		MemoryGB:   types.Int64Value(8),
+		HAReplicas: types.Int64Value(0),
+	}
+	r.refreshEstimatedCost(t.Context(), &model, state)
+	require.Equal(t, state.EstimatedHourlyCost, model.EstimatedHourlyCost)
+	require.Equal(t, state.EstimatedMonthlyCost, model.EstimatedMonthlyCost)
+}
+
+func TestEstimateReplicaSetCost(t *testing.T) {
+	r := &readReplicaSetResource{catalog: newTestCatalog(testProducts)}
+	model := readReplicaSetResourceModel{
+		RegionCode: types.StringValue("us-east-1"),
+		MilliCPU:   types.Int64Value(500),
+		MemoryGB:   types.Int64Value(2),
+		Nodes:      types.Int64Value(3),
+	}
+	hourly, monthly := r.estimateReplicaSetCost(t.Context(), model)
+	require.Equal(t, types.Float64Value(0.0822), hourly)
+	require.Equal(t, types.Float64Value(60.01), monthly)
+
+	// The region of a new read replica set is only known after the apply.
+	unknown := model
+	unknown.RegionCode = types.StringUnknown()
+	hourly, _ = r.estimateReplicaSetCost(t.Context(), unknown)
+	require.True(t, hourly.IsUnknown())
+	hourly, monthly = appliedCost(hourly, types.Float64Unknown(), func() (types.Float64, types.Float64) {
+		return r.estimateReplicaSetCost(t.Context(), model)
+	})
+	require.Equal(t, types.Float64Value(0.0822), hourly)
+	require.Equal(t, types.Float64Value(60.01), monthly)
+}
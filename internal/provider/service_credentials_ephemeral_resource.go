@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &serviceCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &serviceCredentialsEphemeralResource{}
+)
+
+// NewServiceCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewServiceCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceCredentialsEphemeralResource{}
+}
+
+// serviceCredentialsEphemeralResource builds the connection details of a
+// service from its endpoints and a password supplied in the configuration.
+// Its values are never persisted to plan or state.
+type serviceCredentialsEphemeralResource struct {
+	client *tsClient.Client
+}
+
+type serviceCredentialsModel struct {
+	ServiceID            types.String `tfsdk:"service_id"`
+	Password             types.String `tfsdk:"password"`
+	Username             types.String `tfsdk:"username"`
+	Database             types.String `tfsdk:"database"`
+	Hostname             types.String `tfsdk:"hostname"`
+	Port                 types.Int64  `tfsdk:"port"`
+	ReplicaHostname      types.String `tfsdk:"replica_hostname"`
+	ReplicaPort          types.Int64  `tfsdk:"replica_port"`
+	PoolerHostname       types.String `tfsdk:"pooler_hostname"`
+	PoolerPort           types.Int64  `tfsdk:"pooler_port"`
+	ConnectionURI        types.String `tfsdk:"connection_uri"`
+	ReplicaConnectionURI types.String `tfsdk:"replica_connection_uri"`
+	PoolerConnectionURI  types.String `tfsdk:"pooler_connection_uri"`
+}
+
+func (r *serviceCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_credentials"
+}
+
+func (r *serviceCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Builds the connection details of a service, including ready to use ` + "`postgres://`" + ` URIs, from its endpoints and the ` + "`password`" + ` you
+pass in, without persisting them to plan or state. Use it to pass connection details to other providers, for example the PostgreSQL
+provider, or to write-only attributes.
+
+It does not read, generate or verify the password, the API does not return service passwords: pass the password the service was
+given, typically the same value as its ` + "`password_wo`" + ` or the ` + "`password_wo`" + ` of a ` + "`timescale_service_password_rotation`" + `.
+Requires Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the service user, included in the URIs as is. If not set, the URIs have no password.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The Postgres username.",
+				Computed:            true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The default database of the service.",
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the service.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the service.",
+				Computed:            true,
+			},
+			"replica_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the HA replica of the service, if it has one.",
+				Computed:            true,
+			},
+			"replica_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the HA replica of the service, if it has one.",
+				Computed:            true,
+			},
+			"pooler_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the connection pooler of the service, if it is enabled.",
+				Computed:            true,
+			},
+			"pooler_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the connection pooler of the service, if it is enabled.",
+				Computed:            true,
+			},
+			"connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the service, with `sslmode=require`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"replica_connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the HA replica of the service, if it has one.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"pooler_connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the connection pooler of the service, if it is enabled.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *serviceCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	tflog.Trace(ctx, "serviceCredentialsEphemeralResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *serviceCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Trace(ctx, "serviceCredentialsEphemeralResource.Open")
+	var config serviceCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetService(ctx, config.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Service", err.Error())
+		return
+	}
+	result := serviceCredentials(&resp.Diagnostics, service, config.Password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
+
+// serviceCredentials maps the endpoints of a service the same way the
+// timescale_service resource does.
+func serviceCredentials(diags *diag.Diagnostics, s *tsClient.Service, password types.String) serviceCredentialsModel {
+	m := serviceToResource(diags, s, serviceResourceModel{Password: password}, nil)
+	return serviceCredentialsModel{
+		ServiceID:            types.StringValue(s.ID),
+		Password:             password,
+		Username:             m.Username,
+		Database:             types.StringValue(serviceDatabase(s)),
+		Hostname:             m.Hostname,
+		Port:                 m.Port,
+		ReplicaHostname:      m.ReplicaHostname,
+		ReplicaPort:          m.ReplicaPort,
+		PoolerHostname:       m.PoolerHostname,
+		PoolerPort:           m.PoolerPort,
+		ConnectionURI:        m.ConnectionURI,
+		ReplicaConnectionURI: m.ReplicaConnectionURI,
+		PoolerConnectionURI:  m.PoolerConnectionURI,
+	}
+}
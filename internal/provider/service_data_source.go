@@ -40,7 +40,25 @@ type serviceDataSourceModel struct {
 	Created    types.String    `tfsdk:"created"`
 	VpcID      types.Int64     `tfsdk:"vpc_id"`
 
+	PrimaryNode types.String `tfsdk:"primary_node"`
+
 	EnvironmentTag types.String `tfsdk:"environment_tag"`
+	Tags           types.Map    `tfsdk:"tags"`
+
+	Status                  types.String `tfsdk:"status"`
+	ReplicaStatus           types.String `tfsdk:"replica_status"`
+	ForkedFrom              types.String `tfsdk:"forked_from"`
+	Paused                  types.Bool   `tfsdk:"paused"`
+	DataTieringEnabled      types.Bool   `tfsdk:"data_tiering_enabled"`
+	ConnectionPoolerEnabled types.Bool   `tfsdk:"connection_pooler_enabled"`
+	ConnectionPooler        types.Object `tfsdk:"connection_pooler"`
+	MetricExporterID        types.String `tfsdk:"metric_exporter_id"`
+	LogExporterID           types.String `tfsdk:"log_exporter_id"`
+	PgVersion               types.Int64  `tfsdk:"pg_version"`
+	TimescaleDBVersion      types.String `tfsdk:"timescaledb_version"`
+	MaintenanceWindow       types.Object `tfsdk:"maintenance_window"`
+	Autoscaling             types.Object `tfsdk:"autoscaling"`
+	Endpoints               types.List   `tfsdk:"endpoints"`
 }
 
 type specModel struct {
@@ -90,6 +108,10 @@ func (d *serviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: "Region Code is the physical data center where this service is located.",
 				Computed:            true,
 			},
+			"primary_node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node currently acting as primary. Changes after a switchover or failover between HA nodes.",
+				Computed:            true,
+			},
 			"spec": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"hostname": schema.StringAttribute{
@@ -188,6 +210,158 @@ func (d *serviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				Validators:          []validator.String{stringvalidator.OneOf("DEV", "PROD")},
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags of this service.",
+				Description:         "Tags of this service.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "All endpoints of this service: the private endpoint in its VPC, if attached to one, and its primary, replica and pooler endpoints.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "What the endpoint connects to: `primary`, `replica` or `pooler`.",
+							Computed:            true,
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the endpoint.",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the endpoint.",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "`public` if the endpoint is reachable from the internet, `private` if only from the VPC of the service.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current status of the service, such as `READY`, `PAUSED` or `CONFIGURING`.",
+				Computed:            true,
+			},
+			"replica_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the HA replicas of the service. Null when it has none.",
+				Computed:            true,
+			},
+			"forked_from": schema.StringAttribute{
+				MarkdownDescription: "ID of the service this service was forked or replicated from. Null for services created from scratch.",
+				Computed:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service is paused or being paused.",
+				Computed:            true,
+			},
+			"data_tiering_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether data tiering is enabled for this service.",
+				Computed:            true,
+			},
+			"connection_pooler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection pooler is enabled for this service.",
+				Computed:            true,
+			},
+			"connection_pooler": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the connection pooler. Null when the pooler is disabled.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"pool_mode": schema.StringAttribute{
+						MarkdownDescription: "Default pool mode, `session` or `transaction`.",
+						Computed:            true,
+					},
+					"default_pool_size": schema.Int64Attribute{
+						MarkdownDescription: "Server connections of each database per user.",
+						Computed:            true,
+					},
+					"max_client_connections": schema.Int64Attribute{
+						MarkdownDescription: "Client connections the pooler accepts.",
+						Computed:            true,
+					},
+					"databases": schema.MapNestedAttribute{
+						MarkdownDescription: "Overrides of the pool settings per database, keyed by database name.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"pool_mode": schema.StringAttribute{
+									MarkdownDescription: "Pool mode of the database.",
+									Computed:            true,
+								},
+								"pool_size": schema.Int64Attribute{
+									MarkdownDescription: "Server connections of the database per user.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"metric_exporter_id": schema.StringAttribute{
+				MarkdownDescription: "The metric exporter attached to this service.",
+				Computed:            true,
+			},
+			"log_exporter_id": schema.StringAttribute{
+				MarkdownDescription: "The log exporter attached to this service.",
+				Computed:            true,
+			},
+			"pg_version": schema.Int64Attribute{
+				MarkdownDescription: "Major version of Postgres running on this service.",
+				Computed:            true,
+			},
+			"timescaledb_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the TimescaleDB extension running on this service.",
+				Computed:            true,
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "Weekly window, in UTC, during which the platform may apply maintenance to this service.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						MarkdownDescription: "Day of the week the window starts on, such as `SUNDAY`.",
+						Computed:            true,
+					},
+					"start_hour": schema.Int64Attribute{
+						MarkdownDescription: "Hour of the day (0-23, UTC) the window starts at.",
+						Computed:            true,
+					},
+					"duration_hours": schema.Int64Attribute{
+						MarkdownDescription: "Length of the window in hours.",
+						Computed:            true,
+					},
+				},
+			},
+			"autoscaling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Autoscaling bounds of the service. Null when autoscaling is disabled.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"min_milli_cpu": schema.Int64Attribute{
+						MarkdownDescription: "Smallest Milli CPU the service is scaled down to.",
+						Computed:            true,
+					},
+					"min_memory_gb": schema.Int64Attribute{
+						MarkdownDescription: "Smallest memory the service is scaled down to.",
+						Computed:            true,
+					},
+					"max_milli_cpu": schema.Int64Attribute{
+						MarkdownDescription: "Largest Milli CPU the service is scaled up to.",
+						Computed:            true,
+					},
+					"max_memory_gb": schema.Int64Attribute{
+						MarkdownDescription: "Largest memory the service is scaled up to.",
+						Computed:            true,
+					},
+					"scale_up_threshold": schema.Int64Attribute{
+						MarkdownDescription: "CPU utilization percentage above which the service is scaled up.",
+						Computed:            true,
+					},
+					"scale_down_threshold": schema.Int64Attribute{
+						MarkdownDescription: "CPU utilization percentage below which the service is scaled down.",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -198,17 +372,17 @@ func (d *serviceDataSource) Configure(ctx context.Context, req datasource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*tsClient.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Client Type",
-			fmt.Sprintf("Expected *tsClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.client
 }
 
 func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -228,7 +402,7 @@ func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
 	}
-	state := serviceToDataModel(resp.Diagnostics, service)
+	state := serviceToDataModel(&resp.Diagnostics, service)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("error updating terraform state %v", resp.Diagnostics.Errors()))
@@ -236,7 +410,7 @@ func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 }
 
-func serviceToDataModel(diag diag.Diagnostics, s *tsClient.Service) serviceDataSourceModel {
+func serviceToDataModel(diags *diag.Diagnostics, s *tsClient.Service) serviceDataSourceModel {
 	hasHaReplica := s.ReplicaStatus != ""
 	hasPooler := s.ServiceSpec.PoolerEnabled
 
@@ -247,11 +421,31 @@ func serviceToDataModel(diag diag.Diagnostics, s *tsClient.Service) serviceDataS
 		Spec: specModel{
 			Username: types.StringValue(s.ServiceSpec.Username),
 		},
-		Created: types.StringValue(s.Created),
+		Created:     types.StringValue(s.Created),
+		PrimaryNode: types.StringNull(),
+	}
+	if s.PrimaryNode != "" {
+		serviceModel.PrimaryNode = types.StringValue(s.PrimaryNode)
 	}
+	// The remaining attributes are mapped the same way as on the resource.
+	mapped := serviceToResource(diags, s, serviceResourceModel{}, nil)
+	serviceModel.Status = mapped.Status
+	serviceModel.ReplicaStatus = mapped.ReplicaStatus
+	serviceModel.ForkedFrom = mapped.ForkedFrom
+	serviceModel.Paused = mapped.Paused
+	serviceModel.DataTieringEnabled = mapped.DataTieringEnabled
+	serviceModel.ConnectionPoolerEnabled = mapped.ConnectionPoolerEnabled
+	serviceModel.ConnectionPooler = mapped.ConnectionPooler
+	serviceModel.MetricExporterID = mapped.MetricExporterID
+	serviceModel.LogExporterID = mapped.LogExporterID
+	serviceModel.PgVersion = mapped.PgVersion
+	serviceModel.TimescaleDBVersion = mapped.TimescaleDBVersion
+	serviceModel.MaintenanceWindow = mapped.MaintenanceWindow
+	serviceModel.Autoscaling = mapped.Autoscaling
+	serviceModel.Endpoints = mapped.Endpoints
 	if s.VPCEndpoint != nil {
 		if vpcID, err := strconv.ParseInt(s.VPCEndpoint.VPCId, 10, 64); err != nil {
-			diag.AddError("Parse Error", "could not parse vpcID")
+			diags.AddError("Parse Error", "could not parse vpcID")
 		} else {
 			serviceModel.VpcID = types.Int64Value(vpcID)
 		}
@@ -268,13 +462,18 @@ func serviceToDataModel(diag diag.Diagnostics, s *tsClient.Service) serviceDataS
 			},
 		})
 	}
+	tags := map[string]string{}
 	if s.Metadata != nil {
 		serviceModel.EnvironmentTag = types.StringValue(s.Metadata.Environment)
+		tags = s.Metadata.TagMap()
 	}
+	tagsValue, d := types.MapValueFrom(context.Background(), types.StringType, tags)
+	diags.Append(d...)
+	serviceModel.Tags = tagsValue
 
 	if s.Endpoints != nil {
-		serviceModel.Spec.Hostname = types.StringValue(s.Endpoints.Primary.Host)
-		serviceModel.Spec.Port = types.Int64Value(int64(s.Endpoints.Primary.Port))
+		serviceModel.Spec.Hostname = mapped.Hostname
+		serviceModel.Spec.Port = mapped.Port
 
 		if hasHaReplica && s.Endpoints.Replica != nil && s.Endpoints.Replica.Host != "" {
 			serviceModel.Spec.ReplicaHostname = types.StringValue(s.Endpoints.Replica.Host)
@@ -25,6 +25,9 @@ data "timescale_service" "data_source" {
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "name"),
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "region_code"),
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "created"),
+					resource.TestCheckResourceAttr("data.timescale_service.data_source", "status", "READY"),
+					resource.TestCheckResourceAttr("data.timescale_service.data_source", "paused", "false"),
+					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "pg_version"),
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "spec.hostname"),
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "spec.username"),
 					resource.TestCheckResourceAttrSet("data.timescale_service.data_source", "spec.port"),
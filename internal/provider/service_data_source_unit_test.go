@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestServiceToDataModel(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService()
+	s.Status = "PAUSED"
+	s.ForkSpec = &tsClient.ForkSpec{ServiceID: "source"}
+	s.ServiceSpec.PoolerEnabled = true
+	s.ServiceSpec.PoolerSettings = &tsClient.PoolerSettings{PoolMode: "TRANSACTION", DefaultPoolSize: 20, MaxClientConnections: 1000}
+	exporterID := "exporter-1"
+	s.ServiceSpec.MetricExporterUUID = &exporterID
+	s.ServiceSpec.PgVersion = "16"
+	s.DataTieringSettings = &tsClient.DataTieringSettings{Enabled: true}
+	s.Endpoints = &tsClient.ServiceEndpoints{Primary: &tsClient.EndpointAddress{Host: "primary.example.com", Port: 5432}}
+
+	model := serviceToDataModel(&diag.Diagnostics{}, s)
+	require.Equal(t, "PAUSED", model.Status.ValueString())
+	require.True(t, model.Paused.ValueBool())
+	require.Equal(t, "source", model.ForkedFrom.ValueString())
+	require.True(t, model.DataTieringEnabled.ValueBool())
+	require.True(t, model.ConnectionPoolerEnabled.ValueBool())
+	require.Equal(t, "transaction", model.ConnectionPooler.Attributes()["pool_mode"].(types.String).ValueString())
+	require.Equal(t, "exporter-1", model.MetricExporterID.ValueString())
+	require.True(t, model.LogExporterID.IsNull())
+	require.Equal(t, int64(16), model.PgVersion.ValueInt64())
+	require.True(t, model.Autoscaling.IsNull())
+
+	// The model must fit the schema of the data source.
+	schemaResp := &datasource.SchemaResponse{}
+	(&serviceDataSource{}).Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, model)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+}
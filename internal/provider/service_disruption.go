@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// disruptionLevel is the impact of a planned service change on its clients.
+type disruptionLevel int
+
+const (
+	disruptionNone disruptionLevel = iota
+	// disruptionReconnect drops open connections, clients have to reconnect.
+	disruptionReconnect
+	// disruptionDowntime makes the service unavailable while it is applied.
+	disruptionDowntime
+	// disruptionDataLoss replaces the service, its data is lost.
+	disruptionDataLoss
+)
+
+func (l disruptionLevel) String() string {
+	switch l {
+	case disruptionReconnect:
+		return "reconnect"
+	case disruptionDowntime:
+		return "downtime"
+	case disruptionDataLoss:
+		return "data loss"
+	default:
+		return "none"
+	}
+}
+
+// serviceDisruption describes the impact of a change to one attribute.
+type serviceDisruption struct {
+	attribute string
+	level     disruptionLevel
+	reason    string
+}
+
+// classifyServiceChanges lists the planned changes of a service with their impact.
+func classifyServiceChanges(state, plan serviceResourceModel) []serviceDisruption {
+	var changes []serviceDisruption
+	add := func(attribute string, level disruptionLevel, reason string) {
+		changes = append(changes, serviceDisruption{attribute: attribute, level: level, reason: reason})
+	}
+	for attribute, changed := range map[string]bool{
+		"name":                 !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name),
+		"environment_tag":      !plan.EnvironmentTag.IsUnknown() && !plan.EnvironmentTag.Equal(state.EnvironmentTag),
+		"tags":                 !plan.TagsAll.IsUnknown() && !plan.TagsAll.Equal(state.TagsAll),
+		"maintenance_window":   !plan.MaintenanceWindow.IsNull() && !plan.MaintenanceWindow.IsUnknown() && !plan.MaintenanceWindow.Equal(state.MaintenanceWindow),
+		"data_tiering_enabled": !plan.DataTieringEnabled.IsUnknown() && !plan.DataTieringEnabled.Equal(state.DataTieringEnabled),
+		"metric_exporter_id":   !plan.MetricExporterID.IsUnknown() && !plan.MetricExporterID.Equal(state.MetricExporterID),
+		"password":             !plan.Password.IsUnknown() && !plan.Password.IsNull() && !plan.Password.Equal(state.Password),
+		"password_wo_version":  !plan.PasswordWoVersion.Equal(state.PasswordWoVersion),
+	} {
+		if changed {
+			add(attribute, disruptionNone, "applied online")
+		}
+	}
+
+	if !plan.RegionCode.IsUnknown() && !plan.RegionCode.IsNull() && !state.RegionCode.IsNull() && !plan.RegionCode.Equal(state.RegionCode) {
+		add("region_code", disruptionDataLoss, "the service is destroyed and created again in the new region, its data is lost")
+	}
+
+	haReplicas := state.HAReplicas.ValueInt64()
+	// While autoscaling, milli_cpu/memory_gb are a baseline and the service
+	// runs at its current size, which it is resized from once disabled.
+	autoscaled := !state.Autoscaling.IsNull()
+	milliCPU, memoryGB := state.MilliCPU, state.MemoryGB
+	if autoscaled {
+		milliCPU, memoryGB = state.CurrentMilliCPU, state.CurrentMemoryGB
+	}
+	resized := (!plan.MilliCPU.IsUnknown() && !plan.MilliCPU.Equal(milliCPU)) || (!plan.MemoryGB.IsUnknown() && !plan.MemoryGB.Equal(memoryGB))
+	if autoscaled && !plan.Autoscaling.IsNull() {
+		if !plan.MilliCPU.Equal(state.MilliCPU) || !plan.MemoryGB.Equal(state.MemoryGB) {
+			add("milli_cpu/memory_gb", disruptionNone, "only the baseline changes, the size stays managed by autoscaling")
+		}
+	} else if resized {
+		if haReplicas > 0 {
+			add("milli_cpu/memory_gb", disruptionReconnect, "the resize fails over to an HA replica, open connections are dropped")
+		} else {
+			add("milli_cpu/memory_gb", disruptionDowntime, "the resize restarts the database, the service is unavailable until it is back")
+		}
+	}
+	if !plan.Autoscaling.Equal(state.Autoscaling) {
+		switch {
+		case plan.Autoscaling.IsNull():
+			add("autoscaling", disruptionNone, "autoscaling is disabled")
+		case autoscaled:
+			add("autoscaling", disruptionNone, "the autoscaling bounds change")
+		default:
+			add("autoscaling", disruptionNone, "the platform starts resizing the service within the bounds, each resize restarts it")
+		}
+	}
+	if !plan.VpcID.IsUnknown() && !plan.VpcID.Equal(state.VpcID) {
+		add("vpc_id", disruptionReconnect, "the service endpoint moves, open connections are dropped and clients must use the new hostname")
+	}
+	if !plan.HAReplicas.IsUnknown() && !plan.HAReplicas.IsNull() && plan.HAReplicas.ValueInt64() != haReplicas {
+		if plan.HAReplicas.ValueInt64() == 0 {
+			add("ha_replicas", disruptionReconnect, "the replica endpoint is removed and the service loses its failover protection")
+		} else {
+			add("ha_replicas", disruptionNone, "replicas are added or removed online")
+		}
+	}
+	if plan.Paused.ValueBool() != state.Paused.ValueBool() {
+		if plan.Paused.ValueBool() {
+			add("paused", disruptionDowntime, "the service is unavailable until it is resumed")
+		} else {
+			add("paused", disruptionNone, "the service is resumed")
+		}
+	}
+	if plan.ConnectionPoolerEnabled.ValueBool() != state.ConnectionPoolerEnabled.ValueBool() {
+		if plan.ConnectionPoolerEnabled.ValueBool() {
+			add("connection_pooler_enabled", disruptionNone, "a pooler endpoint is added")
+		} else {
+			add("connection_pooler_enabled", disruptionReconnect, "the pooler endpoint is removed, clients using it are disconnected")
+		}
+	} else if !plan.ConnectionPooler.IsNull() && !plan.ConnectionPooler.IsUnknown() && !state.ConnectionPooler.IsNull() && !plan.ConnectionPooler.Equal(state.ConnectionPooler) {
+		from, to := state.ConnectionPooler.Attributes()["pool_mode"], plan.ConnectionPooler.Attributes()["pool_mode"]
+		if !to.IsUnknown() && !to.Equal(from) {
+			add("connection_pooler", disruptionReconnect, "the pool mode changes, clients of the pooler are disconnected")
+		} else {
+			add("connection_pooler", disruptionNone, "the pooler settings are reloaded online")
+		}
+	}
+	if !plan.ReadReplicaNodes.IsUnknown() && !plan.ReadReplicaNodes.IsNull() && !plan.ReadReplicaNodes.Equal(state.ReadReplicaNodes) {
+		if plan.ReadReplicaNodes.ValueInt64() < state.ReadReplicaNodes.ValueInt64() {
+			add("read_replica_nodes", disruptionReconnect, "connections to the removed nodes are dropped")
+		} else {
+			add("read_replica_nodes", disruptionNone, "nodes are added online")
+		}
+	}
+	if isPromotion(state, plan) {
+		add("promote", disruptionReconnect, "replication from the source stops and the replica restarts as a primary")
+	}
+	if !plan.PgVersion.IsNull() && !plan.PgVersion.IsUnknown() && !state.PgVersion.IsNull() && plan.PgVersion.ValueInt64() > state.PgVersion.ValueInt64() {
+		add("pg_version", disruptionDowntime, fmt.Sprintf("Postgres is upgraded from %d to %d, the service is unavailable until the upgrade completes, which can take a while for large databases", state.PgVersion.ValueInt64(), plan.PgVersion.ValueInt64()))
+	}
+	if !plan.TimescaleDBVersion.IsNull() && !plan.TimescaleDBVersion.IsUnknown() && !state.TimescaleDBVersion.IsNull() &&
+		compareVersions(plan.TimescaleDBVersion.ValueString(), state.TimescaleDBVersion.ValueString()) > 0 {
+		add("timescaledb_version", disruptionReconnect, fmt.Sprintf("TimescaleDB is upgraded from %s to %s, the upgrade restarts the service", state.TimescaleDBVersion.ValueString(), plan.TimescaleDBVersion.ValueString()))
+	}
+	if !plan.RestartOnChange.IsNull() && !plan.RestartOnChange.IsUnknown() && !plan.RestartOnChange.Equal(state.RestartOnChange) {
+		add("restart_on_change", disruptionReconnect, "the service is restarted")
+	} else if plan.RestartAfterLogExporterAttach.ValueBool() && !plan.LogExporterID.IsNull() && !plan.LogExporterID.Equal(state.LogExporterID) {
+		add("log_exporter_id", disruptionReconnect, "the service is restarted to complete the log exporter attachment")
+	} else if !plan.LogExporterID.IsUnknown() && !plan.LogExporterID.Equal(state.LogExporterID) {
+		add("log_exporter_id", disruptionNone, "logs are exported after the next restart")
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].level != changes[j].level {
+			return changes[i].level > changes[j].level
+		}
+		return changes[i].attribute < changes[j].attribute
+	})
+	return changes
+}
+
+// reportDisruptions summarizes the planned changes of a service in one
+// diagnostic when any of them is disruptive: a warning, or an error when
+// failOnDisruptive is set. Changes without impact are only listed.
+func reportDisruptions(diags *diag.Diagnostics, serviceName string, changes []serviceDisruption, failOnDisruptive bool) {
+	worst := disruptionNone
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		if c.level > worst {
+			worst = c.level
+		}
+		lines = append(lines, fmt.Sprintf("- %s (%s): %s", c.attribute, c.level, c.reason))
+	}
+	if worst == disruptionNone {
+		return
+	}
+	summary := fmt.Sprintf("Service %q: planned changes cause %s", serviceName, worst)
+	detail := strings.Join(lines, "\n")
+	if failOnDisruptive {
+		diags.AddError(summary, detail+"\n\nThe provider is configured with fail_on_disruptive_changes = true. Apply these changes from a configuration without it, or during a maintenance window.")
+		return
+	}
+	diags.AddWarning(summary, detail)
+}
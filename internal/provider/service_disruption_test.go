@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func disruptionTestModel() serviceResourceModel {
+	return serviceResourceModel{
+		Name:                          types.StringValue("svc"),
+		RegionCode:                    types.StringValue("us-east-1"),
+		MilliCPU:                      types.Int64Value(500),
+		MemoryGB:                      types.Int64Value(2),
+		HAReplicas:                    types.Int64Value(0),
+		Paused:                        types.BoolValue(false),
+		ConnectionPoolerEnabled:       types.BoolValue(false),
+		VpcID:                         types.Int64Null(),
+		PgVersion:                     types.Int64Value(16),
+		TimescaleDBVersion:            types.StringValue("2.17.2"),
+		ReadReplicaSource:             types.StringNull(),
+		ReadReplicaNodes:              types.Int64Null(),
+		TagsAll:                       types.MapNull(types.StringType),
+		RestartOnChange:               types.MapNull(types.StringType),
+		RestartAfterLogExporterAttach: types.BoolValue(false),
+		MaintenanceWindow:             types.ObjectNull(maintenanceWindowAttrTypes),
+		Autoscaling:                   types.ObjectNull(autoscalingAttrTypes),
+		ConnectionPooler:              types.ObjectNull(connectionPoolerAttrTypes),
+	}
+}
+
+func TestClassifyServiceChanges(t *testing.T) {
+	levels := func(changes []serviceDisruption) map[string]disruptionLevel {
+		m := map[string]disruptionLevel{}
+		for _, c := range changes {
+			m[c.attribute] = c.level
+		}
+		return m
+	}
+	state := disruptionTestModel()
+
+	require.Empty(t, classifyServiceChanges(state, disruptionTestModel()))
+
+	plan := disruptionTestModel()
+	plan.Name = types.StringValue("renamed")
+	plan.ConnectionPoolerEnabled = types.BoolValue(true)
+	require.Equal(t, map[string]disruptionLevel{
+		"name":                      disruptionNone,
+		"connection_pooler_enabled": disruptionNone,
+	}, levels(classifyServiceChanges(state, plan)))
+
+	plan = disruptionTestModel()
+	plan.MilliCPU = types.Int64Value(1000)
+	plan.MemoryGB = types.Int64Value(4)
+	plan.VpcID = types.Int64Value(42)
+	plan.PgVersion = types.Int64Value(17)
+	require.Equal(t, map[string]disruptionLevel{
+		"milli_cpu/memory_gb": disruptionDowntime,
+		"vpc_id":              disruptionReconnect,
+		"pg_version":          disruptionDowntime,
+	}, levels(classifyServiceChanges(state, plan)))
+
+	// With HA replicas a resize fails over instead of going down, while dropping the replicas is disruptive.
+	haState := disruptionTestModel()
+	haState.HAReplicas = types.Int64Value(1)
+	plan = disruptionTestModel()
+	plan.MilliCPU = types.Int64Value(1000)
+	plan.MemoryGB = types.Int64Value(4)
+	require.Equal(t, map[string]disruptionLevel{
+		"milli_cpu/memory_gb": disruptionReconnect,
+		"ha_replicas":         disruptionReconnect,
+	}, levels(classifyServiceChanges(haState, plan)))
+
+	// While autoscaling, a baseline change does not resize, but disabling
+	// autoscaling resizes from the current size back to the baseline.
+	autoscaling := autoscalingToModel(&tsClient.AutoscalingSettings{Enabled: true, MinMilliCPU: 500, MinMemoryGB: 2, MaxMilliCPU: 4000, MaxMemoryGB: 16, ScaleUpThreshold: 80, ScaleDownThreshold: 30})
+	autoscaledState := disruptionTestModel()
+	autoscaledState.Autoscaling = autoscaling
+	autoscaledState.CurrentMilliCPU = types.Int64Value(2000)
+	autoscaledState.CurrentMemoryGB = types.Int64Value(8)
+	plan = disruptionTestModel()
+	plan.Autoscaling = autoscaling
+	plan.MilliCPU = types.Int64Value(1000)
+	plan.MemoryGB = types.Int64Value(4)
+	require.Equal(t, map[string]disruptionLevel{
+		"milli_cpu/memory_gb": disruptionNone,
+	}, levels(classifyServiceChanges(autoscaledState, plan)))
+	require.Equal(t, map[string]disruptionLevel{
+		"milli_cpu/memory_gb": disruptionDowntime,
+		"autoscaling":         disruptionNone,
+	}, levels(classifyServiceChanges(autoscaledState, disruptionTestModel())))
+
+	// A pool mode change disconnects the clients of the pooler, other settings are reloaded.
+	poolerState := disruptionTestModel()
+	poolerState.ConnectionPoolerEnabled = types.BoolValue(true)
+	poolerState.ConnectionPooler = poolerSettingsToModel(true, &tsClient.PoolerSettings{PoolMode: "TRANSACTION", DefaultPoolSize: 20, MaxClientConnections: 1000})
+	plan = poolerState
+	plan.ConnectionPooler = poolerSettingsToModel(true, &tsClient.PoolerSettings{PoolMode: "TRANSACTION", DefaultPoolSize: 40, MaxClientConnections: 1000})
+	require.Equal(t, map[string]disruptionLevel{"connection_pooler": disruptionNone}, levels(classifyServiceChanges(poolerState, plan)))
+	plan.ConnectionPooler = poolerSettingsToModel(true, &tsClient.PoolerSettings{PoolMode: "SESSION", DefaultPoolSize: 20, MaxClientConnections: 1000})
+	require.Equal(t, map[string]disruptionLevel{"connection_pooler": disruptionReconnect}, levels(classifyServiceChanges(poolerState, plan)))
+
+	plan = disruptionTestModel()
+	plan.RegionCode = types.StringValue("eu-west-1")
+	changes := classifyServiceChanges(state, plan)
+	require.Len(t, changes, 1)
+	require.Equal(t, disruptionDataLoss, changes[0].level)
+}
+
+func TestReportDisruptions(t *testing.T) {
+	var diags diag.Diagnostics
+	reportDisruptions(&diags, "svc", []serviceDisruption{{attribute: "name", level: disruptionNone, reason: "applied online"}}, true)
+	require.Empty(t, diags, "changes without impact are not reported")
+
+	changes := []serviceDisruption{
+		{attribute: "milli_cpu/memory_gb", level: disruptionDowntime, reason: "restart"},
+		{attribute: "name", level: disruptionNone, reason: "applied online"},
+	}
+	reportDisruptions(&diags, "svc", changes, false)
+	require.Equal(t, 1, diags.WarningsCount())
+	require.Contains(t, diags[0].Summary(), "downtime")
+	require.Contains(t, diags[0].Detail(), "- name (none)")
+
+	diags = nil
+	reportDisruptions(&diags, "svc", changes, true)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "fail_on_disruptive_changes")
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+const (
+	// expiryTagKey is the service tag holding the expiry time of a service.
+	expiryTagKey     = "expires_at"
+	errServiceExpiry = "Service Expired"
+)
+
+// serviceExpiry returns the expiry time stored in the tags of a service.
+func serviceExpiry(s *tsClient.Service) (time.Time, bool) {
+	if s.Metadata == nil {
+		return time.Time{}, false
+	}
+	value, ok := s.Metadata.TagMap()[expiryTagKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiry, true
+}
+
+// expiredServices returns the services that expired before now and have all
+// the filter tags. Read replicas come first, so they are deleted before their
+// primary, and the rest is sorted by name.
+func expiredServices(services []*tsClient.Service, now time.Time, filter map[string]string) []*tsClient.Service {
+	var expired []*tsClient.Service
+	for _, s := range services {
+		expiry, ok := serviceExpiry(s)
+		if !ok || !expiry.Before(now) || !hasTags(s.Metadata.TagMap(), filter) {
+			continue
+		}
+		expired = append(expired, s)
+	}
+	isReplica := func(s *tsClient.Service) bool { return s.ForkSpec != nil && s.ForkSpec.IsStandby }
+	sort.SliceStable(expired, func(i, j int) bool {
+		if isReplica(expired[i]) != isReplica(expired[j]) {
+			return isReplica(expired[i])
+		}
+		return expired[i].Name < expired[j].Name
+	})
+	return expired
+}
+
+// planExpiry plans expires_at from the configured expires_at or ttl, and warns
+// about services that are past it. A ttl counts from the apply that sets it, see
+// applyExpiry: the expiry is kept on later plans until the ttl changes.
+func (r *serviceResource) planExpiry(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configExpiresAt, configTTL, stateExpiresAt, stateTTL types.String
+	var configTags types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_at"), &configExpiresAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ttl"), &configTTL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &stateExpiresAt)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ttl"), &stateTTL)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configTags.IsNull() && !configTags.IsUnknown() && (!configExpiresAt.IsNull() || !configTTL.IsNull()) {
+		if _, ok := configTags.Elements()[expiryTagKey]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("tags"), ErrInvalidAttribute, fmt.Sprintf("the %s tag is set from expires_at or ttl, remove it from tags", expiryTagKey))
+			return
+		}
+	}
+
+	expiresAt := types.StringNull()
+	switch {
+	case !configExpiresAt.IsNull():
+		expiresAt = configExpiresAt
+	case configTTL.IsUnknown():
+		expiresAt = types.StringUnknown()
+	case !configTTL.IsNull():
+		if configTTL.Equal(stateTTL) && !stateExpiresAt.IsNull() {
+			expiresAt = stateExpiresAt
+			break
+		}
+		// Set on apply, planning the current time would not survive the
+		// plan that Terraform makes again when applying.
+		expiresAt = types.StringUnknown()
+	case configTags.IsUnknown():
+		expiresAt = types.StringUnknown()
+	case !configTags.IsNull():
+		// An expiry set directly in tags is reported as is.
+		if v, ok := configTags.Elements()[expiryTagKey].(types.String); ok {
+			expiresAt = v
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
+
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+	if expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err == nil && expiry.Before(time.Now()) {
+		var name types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.AddAttributeWarning(path.Root("expires_at"), errServiceExpiry,
+			fmt.Sprintf("Service %q expired at %s. Remove it from the configuration to delete it, or extend expires_at or ttl to keep it.", name.ValueString(), expiresAt.ValueString()))
+	}
+}
+
+// applyExpiry resolves an expiry planned from a ttl to the time of the apply
+// plus the ttl, and adds it to the planned tags.
+func (r *serviceResource) applyExpiry(ctx context.Context, plan *serviceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.ExpiresAt.IsUnknown() || plan.TTL.IsNull() || plan.TTL.IsUnknown() {
+		return diags
+	}
+	ttl, err := time.ParseDuration(plan.TTL.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("ttl"), ErrInvalidAttribute, err.Error())
+		return diags
+	}
+	plan.ExpiresAt = types.StringValue(expiryAfter(time.Now(), ttl))
+
+	var configured map[string]string
+	if !plan.Tags.IsNull() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &configured, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	tagsAll, d := types.MapValueFrom(ctx, types.StringType, withExpiryTag(mergeTags(r.defaultTags, configured), plan.ExpiresAt))
+	diags.Append(d...)
+	plan.TagsAll = tagsAll
+	return diags
+}
+
+// expiryAfter formats the time ttl after now as an RFC 3339 UTC timestamp.
+func expiryAfter(now time.Time, ttl time.Duration) string {
+	return now.UTC().Add(ttl).Truncate(time.Second).Format(time.RFC3339)
+}
+
+// withExpiryTag returns the tags of a service with its planned expiry.
+func withExpiryTag(tags map[string]string, expiresAt types.String) map[string]string {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return tags
+	}
+	tags[expiryTagKey] = expiresAt.ValueString()
+	return tags
+}
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp such as 2030-01-02T15:04:05Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp", fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2030-01-02T15:04:05Z.", req.ConfigValue.ValueString()))
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// newExpiringTestService returns a test service with the given expiry tag.
+func newExpiringTestService(id, name, expiresAt string, tags ...tsClient.ServiceTag) *tsClient.Service {
+	s := newTestService()
+	s.ID, s.Name = id, name
+	s.Metadata = &tsClient.Metadata{Tags: tags}
+	if expiresAt != "" {
+		s.Metadata.Tags = append(s.Metadata.Tags, tsClient.ServiceTag{Key: expiryTagKey, Value: expiresAt})
+	}
+	return s
+}
+
+func TestExpiredServices(t *testing.T) {
+	now := time.Date(2030, 1, 2, 12, 0, 0, 0, time.UTC)
+	replica := newExpiringTestService("svc-4", "a-replica", "2030-01-01T00:00:00Z")
+	replica.ForkSpec = &tsClient.ForkSpec{ServiceID: "svc-2", IsStandby: true}
+	services := []*tsClient.Service{
+		newExpiringTestService("svc-1", "preview-b", "2030-01-02T11:59:59Z", tsClient.ServiceTag{Key: "ci", Value: "true"}),
+		newExpiringTestService("svc-2", "preview-a", "2030-01-01T00:00:00+02:00"),
+		newExpiringTestService("svc-3", "not-expired", "2030-01-02T12:00:01Z"),
+		replica,
+		newExpiringTestService("svc-5", "no-expiry", ""),
+		newExpiringTestService("svc-6", "invalid", "tomorrow"),
+		{ID: "svc-7", Name: "no-metadata"},
+	}
+
+	var ids []string
+	for _, s := range expiredServices(services, now, nil) {
+		ids = append(ids, s.ID)
+	}
+	require.Equal(t, []string{"svc-4", "svc-2", "svc-1"}, ids, "read replicas first, then by name")
+
+	filtered := expiredServices(services, now, map[string]string{"ci": "true"})
+	require.Len(t, filtered, 1)
+	require.Equal(t, "svc-1", filtered[0].ID)
+}
+
+func TestExpiryAfter(t *testing.T) {
+	now := time.Date(2030, 1, 2, 12, 0, 0, 500, time.FixedZone("CET", 3600))
+	require.Equal(t, "2030-01-05T11:00:00Z", expiryAfter(now, 72*time.Hour))
+}
+
+func TestServiceToResource_Expiry(t *testing.T) {
+	s := newExpiringTestService("svc-1", "preview", "2030-01-02T12:00:00Z", tsClient.ServiceTag{Key: "team", Value: "data"})
+
+	model := serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{TTL: types.StringValue("72h")}, nil)
+	require.Equal(t, "2030-01-02T12:00:00Z", model.ExpiresAt.ValueString())
+	require.Equal(t, "72h", model.TTL.ValueString())
+	require.Len(t, model.TagsAll.Elements(), 2)
+	require.Equal(t, map[string]attr.Value{"team": types.StringValue("data")}, model.Tags.Elements(), "the expiry tag is not part of tags")
+
+	configured := types.MapValueMust(types.StringType, map[string]attr.Value{expiryTagKey: types.StringValue("2030-01-02T12:00:00Z")})
+	model = serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{Tags: configured}, nil)
+	require.Contains(t, model.Tags.Elements(), expiryTagKey, "an expiry configured in tags stays in tags")
+
+	model = serviceToResource(&diag.Diagnostics{}, newTestService(), serviceResourceModel{}, nil)
+	require.True(t, model.ExpiresAt.IsNull())
+}
+
+func TestApplyExpiry(t *testing.T) {
+	r := &serviceResource{defaultTags: map[string]string{"owner": "ci"}}
+	plan := serviceResourceModel{
+		TTL:       types.StringValue("1h"),
+		ExpiresAt: types.StringUnknown(),
+		Tags:      types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}),
+		TagsAll:   types.MapUnknown(types.StringType),
+	}
+	before := time.Now()
+	require.False(t, r.applyExpiry(context.Background(), &plan).HasError())
+
+	expiry, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+	require.NoError(t, err)
+	require.WithinDuration(t, before.Add(time.Hour), expiry, 2*time.Second)
+	require.Equal(t, map[string]attr.Value{
+		"owner":      types.StringValue("ci"),
+		"team":       types.StringValue("data"),
+		expiryTagKey: plan.ExpiresAt,
+	}, plan.TagsAll.Elements())
+
+	// A planned expiry is left alone
+	known := plan
+	require.False(t, r.applyExpiry(context.Background(), &known).HasError())
+	require.Equal(t, plan, known)
+}
+
+func TestPlanExpiry(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r := &serviceResource{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	toRaw := func(m serviceResourceModel) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := state.Set(ctx, m)
+		require.False(t, diags.HasError(), "diags: %v", diags)
+		return state.Raw
+	}
+	model := func(ttl, expiresAt types.String) serviceResourceModel {
+		m := serviceToResource(&diag.Diagnostics{}, newTestService(), serviceResourceModel{
+			Timeouts:        timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})},
+			Tags:            types.MapNull(types.StringType),
+			RestartOnChange: types.MapNull(types.StringType),
+			TTL:             ttl,
+		}, nil)
+		m.ExpiresAt = expiresAt
+		return m
+	}
+	planExpiry := func(state, config serviceResourceModel) (types.String, diag.Diagnostics) {
+		req := resource.ModifyPlanRequest{
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: toRaw(state)},
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: toRaw(config)},
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: toRaw(config)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.planExpiry(ctx, req, resp)
+		var expiresAt types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+		return expiresAt, resp.Diagnostics
+	}
+
+	stateExpiry := types.StringValue("2100-01-01T00:00:00Z")
+	state := model(types.StringValue("24h"), stateExpiry)
+
+	// An unchanged ttl keeps the expiry
+	expiresAt, diags := planExpiry(state, model(types.StringValue("24h"), types.StringNull()))
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.Equal(t, stateExpiry, expiresAt)
+
+	// A changed ttl counts again from the apply
+	expiresAt, diags = planExpiry(state, model(types.StringValue("48h"), types.StringNull()))
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.True(t, expiresAt.IsUnknown())
+
+	// Removing the ttl removes the expiry
+	expiresAt, _ = planExpiry(state, model(types.StringNull(), types.StringNull()))
+	require.True(t, expiresAt.IsNull())
+
+	// Past expiries are reported
+	past := types.StringValue("2020-01-01T00:00:00Z")
+	expiresAt, diags = planExpiry(model(types.StringNull(), past), model(types.StringNull(), past))
+	require.Equal(t, past, expiresAt)
+	require.Equal(t, 1, diags.WarningsCount())
+	require.Contains(t, diags.Warnings()[0].Detail(), "expired at 2020-01-01T00:00:00Z")
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &serviceIPAllowlistDataSource{}
+var _ datasource.DataSourceWithConfigure = &serviceIPAllowlistDataSource{}
+
+func NewServiceIPAllowlistDataSource() datasource.DataSource {
+	return &serviceIPAllowlistDataSource{}
+}
+
+// serviceIPAllowlistDataSource defines the data source implementation.
+type serviceIPAllowlistDataSource struct {
+	client *tsClient.Client
+}
+
+type serviceIPAllowlistDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+	CIDRBlocks types.List   `tfsdk:"cidr_blocks"`
+}
+
+func (d *serviceIPAllowlistDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_ip_allowlist"
+}
+
+func (d *serviceIPAllowlistDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "IP allowlist of a service's public endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Same as `service_id`.",
+				Computed:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service.",
+				Required:            true,
+			},
+			"cidr_blocks": schema.ListAttribute{
+				MarkdownDescription: "CIDR blocks allowed to connect to the service. Empty when the endpoint is reachable from anywhere.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *serviceIPAllowlistDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "ServiceIPAllowlistDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Client Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *serviceIPAllowlistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "ServiceIPAllowlistDataSource.Read")
+
+	var state serviceIPAllowlistDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidrBlocks, err := d.client.GetServiceIPAllowlist(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IP allowlist, got error: %s", err))
+		return
+	}
+	if cidrBlocks == nil {
+		cidrBlocks = []string{}
+	}
+	cidrList, diags := types.ListValueFrom(ctx, types.StringType, cidrBlocks)
+	resp.Diagnostics.Append(diags...)
+	state.ID = state.ServiceID
+	state.CIDRBlocks = cidrList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceIPAllowlistResource{}
+	_ resource.ResourceWithConfigure   = &serviceIPAllowlistResource{}
+	_ resource.ResourceWithImportState = &serviceIPAllowlistResource{}
+)
+
+// NewServiceIPAllowlistResource is a helper function to simplify the provider implementation.
+func NewServiceIPAllowlistResource() resource.Resource {
+	return &serviceIPAllowlistResource{}
+}
+
+// serviceIPAllowlistResource is the resource implementation.
+type serviceIPAllowlistResource struct {
+	client *tsClient.Client
+}
+
+type serviceIPAllowlistResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+	CIDRBlocks types.List   `tfsdk:"cidr_blocks"`
+}
+
+// Metadata returns the resource type name.
+func (r *serviceIPAllowlistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_ip_allowlist"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceIPAllowlistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the IP allowlist of a service's public endpoint.
+
+Without an allowlist, the public endpoint of a service that is not attached to a VPC is reachable from anywhere.
+Destroying this resource removes the restriction. Existing allowlists can be imported by service ID.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. Same as `service_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr_blocks": schema.ListAttribute{
+				MarkdownDescription: "CIDR blocks allowed to connect to the service, e.g. `203.0.113.0/24`. IPv4 and IPv6 are supported. Order is not significant and duplicates are ignored.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(cidrValidator{}),
+				},
+				PlanModifiers: []planmodifier.List{
+					OrderInsensitiveList(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceIPAllowlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "serviceIPAllowlistResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+}
+
+// Create sets the allowlist of the service.
+func (r *serviceIPAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "serviceIPAllowlistResource.Create")
+	var plan serviceIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var cidrBlocks []string
+	resp.Diagnostics.Append(plan.CIDRBlocks.ElementsAs(ctx, &cidrBlocks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetServiceIPAllowlist(ctx, plan.ServiceID.ValueString(), normalizeCIDRs(cidrBlocks)); err != nil {
+		resp.Diagnostics.AddError("Unable to Set IP Allowlist", err.Error())
+		return
+	}
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceIPAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "serviceIPAllowlistResource.Read")
+	var state serviceIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidrBlocks, err := r.client.GetServiceIPAllowlist(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Service not found, removing IP allowlist from state.", map[string]any{"service_id": state.ServiceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read IP Allowlist", err.Error())
+		return
+	}
+
+	if cidrBlocks == nil {
+		cidrBlocks = []string{}
+	}
+	var known []string
+	if !state.CIDRBlocks.IsNull() {
+		resp.Diagnostics.Append(state.CIDRBlocks.ElementsAs(ctx, &known, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	cidrList, diags := types.ListValueFrom(ctx, types.StringType, keepOrder(known, cidrBlocks))
+	resp.Diagnostics.Append(diags...)
+	state.ID = state.ServiceID
+	state.CIDRBlocks = cidrList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the allowlist of the service.
+func (r *serviceIPAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "serviceIPAllowlistResource.Update")
+	var plan serviceIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var cidrBlocks []string
+	resp.Diagnostics.Append(plan.CIDRBlocks.ElementsAs(ctx, &cidrBlocks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetServiceIPAllowlist(ctx, plan.ServiceID.ValueString(), normalizeCIDRs(cidrBlocks)); err != nil {
+		resp.Diagnostics.AddError("Unable to Update IP Allowlist", err.Error())
+		return
+	}
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the allowlist, making the public endpoint reachable from anywhere again.
+func (r *serviceIPAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "serviceIPAllowlistResource.Delete")
+	var state serviceIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetServiceIPAllowlist(ctx, state.ServiceID.ValueString(), nil)
+	if err != nil && !errors.Is(err, tsClient.ErrServiceNotFound) {
+		resp.Diagnostics.AddError("Unable to Remove IP Allowlist", err.Error())
+	}
+}
+
+// ImportState imports the allowlist of a service by its ID.
+func (r *serviceIPAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// keepOrder returns known when it holds the same blocks as current, so that
+// a reordering or deduplication by the API does not show up as drift.
+func keepOrder(known, current []string) []string {
+	a, b := normalizeCIDRs(known), normalizeCIDRs(current)
+	sort.Strings(a)
+	sort.Strings(b)
+	if len(known) > 0 && slices.Equal(a, b) {
+		return known
+	}
+	return current
+}
+
+// normalizeCIDRs returns the blocks in canonical form and without duplicates,
+// in the order they are first listed.
+func normalizeCIDRs(blocks []string) []string {
+	normalized := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if prefix, err := netip.ParsePrefix(block); err == nil {
+			block = prefix.String()
+		}
+		if !slices.Contains(normalized, block) {
+			normalized = append(normalized, block)
+		}
+	}
+	return normalized
+}
+
+// cidrValidator validates that a string is a CIDR block in canonical form.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be a CIDR block such as 203.0.113.0/24"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR Block", err.Error())
+	}
+}
+
+// validateCIDR rejects malformed blocks and blocks with host bits set, which the
+// API would store masked and report back as drift.
+func validateCIDR(value string) error {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid CIDR block: %w", value, err)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return fmt.Errorf("%q has host bits set, did you mean %q?", value, masked.String())
+	}
+	return nil
+}
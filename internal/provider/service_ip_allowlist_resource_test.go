@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func serviceIPAllowlistConfig(cidrBlocks string) string {
+	return providerConfig + `
+resource "timescale_service" "allowlist" {
+  name        = "tf-acc-test-ip-allowlist"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+}
+
+resource "timescale_service_ip_allowlist" "allowlist" {
+  service_id  = timescale_service.allowlist.id
+  cidr_blocks = ` + cidrBlocks + `
+}
+
+data "timescale_service_ip_allowlist" "allowlist" {
+  service_id = timescale_service_ip_allowlist.allowlist.service_id
+}
+`
+}
+
+func TestAcc_ServiceIPAllowlistResource(t *testing.T) {
+	resourceName := "timescale_service_ip_allowlist.allowlist"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      serviceIPAllowlistConfig(`["203.0.113.7/24"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("has host bits set"),
+			},
+			{
+				Config:      serviceIPAllowlistConfig(`["203.0.113.0/24", "203.0.113.0/24"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unique"),
+			},
+			{
+				Config: serviceIPAllowlistConfig(`["203.0.113.0/24", "198.51.100.7/32"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "timescale_service.allowlist", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr("data.timescale_service_ip_allowlist.allowlist", "cidr_blocks.#", "2"),
+				),
+			},
+			{
+				// Reordering must not produce a diff.
+				Config:   serviceIPAllowlistConfig(`["198.51.100.7/32", "203.0.113.0/24"]`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
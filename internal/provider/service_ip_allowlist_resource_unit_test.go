@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCIDR(t *testing.T) {
+	require.NoError(t, validateCIDR("203.0.113.0/24"))
+	require.NoError(t, validateCIDR("198.51.100.7/32"))
+	require.NoError(t, validateCIDR("2001:db8::/32"))
+	require.ErrorContains(t, validateCIDR("203.0.113.0"), "not a valid CIDR block")
+	require.ErrorContains(t, validateCIDR("203.0.113.0/33"), "not a valid CIDR block")
+	require.ErrorContains(t, validateCIDR("203.0.113.7/24"), `did you mean "203.0.113.0/24"`)
+}
+
+func TestKeepOrder(t *testing.T) {
+	known := []string{"10.0.0.0/8", "192.168.0.0/16"}
+	require.Equal(t, known, keepOrder(known, []string{"192.168.0.0/16", "10.0.0.0/8"}))
+	require.Equal(t, []string{"10.0.0.0/8"}, keepOrder(known, []string{"10.0.0.0/8"}))
+	require.Equal(t, []string{"172.16.0.0/12"}, keepOrder(nil, []string{"172.16.0.0/12"}))
+
+	// The API stores each block once, in canonical form.
+	duplicated := []string{"10.0.0.0/8", "2001:DB8::/32", "10.0.0.0/8"}
+	require.Equal(t, duplicated, keepOrder(duplicated, []string{"2001:db8::/32", "10.0.0.0/8"}))
+	require.Equal(t, []string{}, keepOrder(nil, []string{}))
+}
+
+func TestNormalizeCIDRs(t *testing.T) {
+	require.Equal(t, []string{"10.0.0.0/8", "2001:db8::/32"}, normalizeCIDRs([]string{"10.0.0.0/8", "2001:DB8::/32", "10.0.0.0/8", "2001:db8::/32"}))
+	require.Empty(t, normalizeCIDRs(nil))
+}
@@ -0,0 +1,554 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceParametersResource{}
+	_ resource.ResourceWithConfigure   = &serviceParametersResource{}
+	_ resource.ResourceWithImportState = &serviceParametersResource{}
+	_ resource.ResourceWithModifyPlan  = &serviceParametersResource{}
+)
+
+type parameterKind int
+
+const (
+	parameterInteger parameterKind = iota
+	parameterReal
+	parameterBool
+	// parameterMemory values are expressed in kB unless a unit is given.
+	parameterMemory
+	// parameterDuration values are expressed in ms unless a unit is given.
+	parameterDuration
+	parameterEnum
+)
+
+type parameterSpec struct {
+	kind     parameterKind
+	min, max float64
+	values   []string
+	// restart is true when the parameter only takes effect after a service restart.
+	restart bool
+}
+
+const maxInt32 = math.MaxInt32
+
+// serviceParameters lists the server parameters the platform allows to be changed.
+// Ranges are in the parameter's base unit (kB for memory, ms for durations).
+var serviceParameters = map[string]parameterSpec{
+	"max_connections":                     {kind: parameterInteger, min: 25, max: 5000, restart: true},
+	"max_locks_per_transaction":           {kind: parameterInteger, min: 10, max: maxInt32, restart: true},
+	"timescaledb.max_background_workers":  {kind: parameterInteger, min: 0, max: 1000, restart: true},
+	"max_parallel_workers":                {kind: parameterInteger, min: 0, max: 1024},
+	"max_parallel_workers_per_gather":     {kind: parameterInteger, min: 0, max: 1024},
+	"default_statistics_target":           {kind: parameterInteger, min: 1, max: 10000},
+	"work_mem":                            {kind: parameterMemory, min: 64, max: 2097151},
+	"maintenance_work_mem":                {kind: parameterMemory, min: 1024, max: 2097151},
+	"statement_timeout":                   {kind: parameterDuration, min: 0, max: maxInt32},
+	"idle_in_transaction_session_timeout": {kind: parameterDuration, min: 0, max: maxInt32},
+	"lock_timeout":                        {kind: parameterDuration, min: 0, max: maxInt32},
+	"log_min_duration_statement":          {kind: parameterDuration, min: -1, max: maxInt32},
+	"random_page_cost":                    {kind: parameterReal, min: 0, max: 10000},
+	"jit":                                 {kind: parameterBool},
+	"log_statement":                       {kind: parameterEnum, values: []string{"none", "ddl", "mod", "all"}},
+}
+
+var (
+	memoryUnits = map[string]float64{"": 1, "kB": 1, "MB": 1024, "GB": 1024 * 1024, "TB": 1024 * 1024 * 1024}
+	// durationUnits are relative to milliseconds.
+	durationUnits = map[string]float64{"": 1, "us": 0.001, "ms": 1, "s": 1000, "min": 60 * 1000, "h": 60 * 60 * 1000, "d": 24 * 60 * 60 * 1000}
+	unitValueRe   = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s*([a-zA-Z]*)$`)
+)
+
+// normalizeParameter validates value against the parameter spec and returns it in
+// a canonical form, so that values like "64MB" and "65536" compare as equal.
+func normalizeParameter(name, value string) (string, error) {
+	spec, ok := serviceParameters[name]
+	if !ok {
+		return "", fmt.Errorf("unsupported parameter %q, supported parameters are: %s", name, strings.Join(supportedParameterNames(), ", "))
+	}
+	value = strings.TrimSpace(value)
+
+	switch spec.kind {
+	case parameterBool:
+		switch strings.ToLower(value) {
+		case "on", "true", "yes", "1":
+			return "on", nil
+		case "off", "false", "no", "0":
+			return "off", nil
+		}
+		return "", fmt.Errorf("%s must be a boolean (on/off), got %q", name, value)
+	case parameterEnum:
+		v := strings.ToLower(value)
+		if !slices.Contains(spec.values, v) {
+			return "", fmt.Errorf("%s must be one of %s, got %q", name, strings.Join(spec.values, ", "), value)
+		}
+		return v, nil
+	case parameterInteger:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be an integer, got %q", name, value)
+		}
+		if err := checkParameterRange(name, spec, float64(n), ""); err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(n, 10), nil
+	case parameterReal:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a number, got %q", name, value)
+		}
+		if err := checkParameterRange(name, spec, f, ""); err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	units, baseUnit := memoryUnits, "kB"
+	if spec.kind == parameterDuration {
+		units, baseUnit = durationUnits, "ms"
+	}
+	m := unitValueRe.FindStringSubmatch(value)
+	if m == nil {
+		return "", fmt.Errorf("%s must be a number with an optional unit, got %q", name, value)
+	}
+	multiplier, ok := units[m[2]]
+	if !ok {
+		valid := make([]string, 0, len(units))
+		for u := range units {
+			if u != "" {
+				valid = append(valid, u)
+			}
+		}
+		sort.Strings(valid)
+		return "", fmt.Errorf("%s has invalid unit %q, valid units are: %s", name, m[2], strings.Join(valid, ", "))
+	}
+	f, _ := strconv.ParseFloat(m[1], 64)
+	base := math.Round(f * multiplier)
+	if err := checkParameterRange(name, spec, base, baseUnit); err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(base, 'f', -1, 64), nil
+}
+
+func checkParameterRange(name string, spec parameterSpec, v float64, unit string) error {
+	if v < spec.min || v > spec.max {
+		return fmt.Errorf("%s must be between %s%s and %s%s, got %s%s", name,
+			strconv.FormatFloat(spec.min, 'f', -1, 64), unit,
+			strconv.FormatFloat(spec.max, 'f', -1, 64), unit,
+			strconv.FormatFloat(v, 'f', -1, 64), unit)
+	}
+	return nil
+}
+
+func supportedParameterNames() []string {
+	names := make([]string, 0, len(serviceParameters))
+	for name := range serviceParameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parameterChanges returns the parameters to set and the ones to reset to
+// their default when going from the old to the new configuration.
+func parameterChanges(old, new map[string]string) (set map[string]string, reset []string) {
+	set = map[string]string{}
+	for name, value := range new {
+		prev, ok := old[name]
+		if !ok || !parameterValuesEqual(name, prev, value) {
+			set[name] = value
+		}
+	}
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			reset = append(reset, name)
+		}
+	}
+	sort.Strings(reset)
+	return set, reset
+}
+
+func parameterValuesEqual(name, a, b string) bool {
+	na, errA := normalizeParameter(name, a)
+	nb, errB := normalizeParameter(name, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return na == nb
+}
+
+// splitByRestart splits parameter names into the ones that require a restart
+// and the ones that are applied with a configuration reload.
+func splitByRestart(names []string) (restart, reload []string) {
+	sort.Strings(names)
+	for _, name := range names {
+		if serviceParameters[name].restart {
+			restart = append(restart, name)
+		} else {
+			reload = append(reload, name)
+		}
+	}
+	return restart, reload
+}
+
+// serviceParametersValidator validates parameter names, types and ranges at plan time.
+type serviceParametersValidator struct{}
+
+func (v serviceParametersValidator) Description(_ context.Context) string {
+	return "parameters must be supported and have values of the right type and range"
+}
+
+func (v serviceParametersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v serviceParametersValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var parameters map[string]types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name, value := range parameters {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if _, err := normalizeParameter(name, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtMapKey(name), "Invalid Service Parameter", err.Error())
+		}
+	}
+}
+
+// NewServiceParametersResource is a helper function to simplify the provider implementation.
+func NewServiceParametersResource() resource.Resource {
+	return &serviceParametersResource{}
+}
+
+// serviceParametersResource is the resource implementation.
+type serviceParametersResource struct {
+	client *tsClient.Client
+}
+
+type serviceParametersResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ServiceID  types.String   `tfsdk:"service_id"`
+	Parameters types.Map      `tfsdk:"parameters"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *serviceParametersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_parameters"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceParametersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages Postgres server configuration parameters of a service.
+
+Only the parameters present in ` + "`parameters`" + ` are managed; removing a parameter resets it to the platform default.
+Parameters are applied with a configuration reload, except ` + "`" + strings.Join(restartParameterNames(), "`, `") + "`" + `,
+which restart the service, also when they are reset to their defaults on destroy. The plan reports which kind of change each parameter needs, and restarts wait for the service to be ready again.
+
+Supported parameters: ` + "`" + strings.Join(supportedParameterNames(), "`, `") + "`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. Same as `service_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service to configure.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "Map of parameter names to values. Memory values accept the `kB`, `MB`, `GB` and `TB` units (default `kB`), durations accept `us`, `ms`, `s`, `min`, `h` and `d` (default `ms`).",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Map{
+					serviceParametersValidator{},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func restartParameterNames() []string {
+	restart, _ := splitByRestart(supportedParameterNames())
+	return restart
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceParametersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "serviceParametersResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+}
+
+// ModifyPlan reports whether the planned parameter changes need a restart or only a reload.
+func (r *serviceParametersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan serviceParametersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Parameters.IsUnknown() {
+		return
+	}
+	old := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state serviceParametersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(state.Parameters.ElementsAs(ctx, &old, false)...)
+	}
+	var planned map[string]string
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, reset := parameterChanges(old, planned)
+	changed := reset
+	for name := range set {
+		changed = append(changed, name)
+	}
+	restart, reload := splitByRestart(changed)
+	if len(restart) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("parameters"), "Service Restart Required",
+			fmt.Sprintf("Changing %s restarts service %s, interrupting open connections.", strings.Join(restart, ", "), plan.ServiceID.ValueString()))
+	}
+	if len(reload) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("parameters"), "Service Configuration Reload",
+			fmt.Sprintf("Changing %s is applied with a configuration reload, without a restart.", strings.Join(reload, ", ")))
+	}
+}
+
+// Create sets the configured parameters.
+func (r *serviceParametersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "serviceParametersResource.Create")
+	var plan serviceParametersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var parameters map[string]string
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+	if err := r.applyParameters(ctx, serviceID, parameters, nil, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Set Service Parameters", err.Error())
+		return
+	}
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceParametersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "serviceParametersResource.Read")
+	var state serviceParametersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetServiceParameters(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Service not found, removing parameters from state.", map[string]any{"service_id": state.ServiceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read Service Parameters", err.Error())
+		return
+	}
+
+	managed := map[string]string{}
+	if !state.Parameters.IsNull() {
+		resp.Diagnostics.Append(state.Parameters.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	parameters := parametersFromAPI(managed, current, state.Parameters.IsNull())
+
+	parametersValue, diags := types.MapValueFrom(ctx, types.StringType, parameters)
+	resp.Diagnostics.Append(diags...)
+	state.ID = state.ServiceID
+	state.Parameters = parametersValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// parametersFromAPI maps the service parameters to the resource state. Only managed
+// parameters are tracked, keeping their configured spelling when the value is
+// equivalent. On import, every supported parameter changed from its default is adopted.
+func parametersFromAPI(managed map[string]string, current []*tsClient.ServiceParameter, importing bool) map[string]string {
+	parameters := map[string]string{}
+	for _, p := range current {
+		if _, supported := serviceParameters[p.Name]; !supported {
+			continue
+		}
+		prev, ok := managed[p.Name]
+		switch {
+		case ok && parameterValuesEqual(p.Name, prev, p.Value):
+			parameters[p.Name] = prev
+		case ok, importing && !p.IsDefault:
+			parameters[p.Name] = p.Value
+		}
+	}
+	return parameters
+}
+
+// Update sets changed parameters and resets removed ones.
+func (r *serviceParametersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "serviceParametersResource.Update")
+	var plan, state serviceParametersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var planned, old map[string]string
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Parameters.ElementsAs(ctx, &old, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Update(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, reset := parameterChanges(old, planned)
+	if err := r.applyParameters(ctx, plan.ServiceID.ValueString(), set, reset, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Update Service Parameters", err.Error())
+		return
+	}
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resets the managed parameters to their defaults, restarting the
+// service like Update when one of them requires it.
+func (r *serviceParametersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "serviceParametersResource.Delete")
+	var state serviceParametersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var parameters map[string]string
+	resp.Diagnostics.Append(state.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() || len(parameters) == 0 {
+		return
+	}
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	timeout, diags := state.Timeouts.Delete(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyParameters(ctx, state.ServiceID.ValueString(), nil, names, timeout)
+	if err != nil && !errors.Is(err, tsClient.ErrServiceNotFound) {
+		resp.Diagnostics.AddError("Unable to Reset Service Parameters", err.Error())
+	}
+}
+
+// ImportState imports the parameters of a service by its ID.
+func (r *serviceParametersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// applyParameters sets and resets parameters. When any of them requires a
+// restart, the service is restarted and waited on until it is ready again.
+func (r *serviceParametersResource) applyParameters(ctx context.Context, serviceID string, set map[string]string, reset []string, timeout time.Duration) error {
+	changed := slices.Clone(reset)
+	if len(set) > 0 {
+		if err := r.client.SetServiceParameters(ctx, serviceID, set); err != nil {
+			return err
+		}
+		for name := range set {
+			changed = append(changed, name)
+		}
+	}
+	if len(reset) > 0 {
+		if err := r.client.ResetServiceParameters(ctx, serviceID, reset); err != nil {
+			return err
+		}
+	}
+
+	restart, _ := splitByRestart(changed)
+	if len(restart) == 0 {
+		return nil
+	}
+	tflog.Info(ctx, "restarting service to apply parameters", map[string]any{"service_id": serviceID, "parameters": restart})
+	if err := r.client.RestartService(ctx, serviceID); err != nil {
+		return fmt.Errorf("parameters %s were changed but the service restart that applies them failed: %w", strings.Join(restart, ", "), err)
+	}
+	_, err := waitForServiceReadiness(ctx, r.client, serviceID, timeout)
+	return err
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func serviceParametersConfig(parameters string) string {
+	return providerConfig + `
+resource "timescale_service" "params" {
+  name        = "tf-acc-test-parameters"
+  milli_cpu   = 500
+  memory_gb   = 2
+  region_code = "us-east-1"
+}
+
+resource "timescale_service_parameters" "params" {
+  service_id = timescale_service.params.id
+  parameters = {
+` + parameters + `
+  }
+}
+`
+}
+
+func TestAcc_ServiceParametersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      serviceParametersConfig(`    work_mem = "4GB"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be between 64kB and 2097151kB"),
+			},
+			{
+				Config: serviceParametersConfig(`    work_mem = "64MB"
+    statement_timeout = "30s"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("timescale_service_parameters.params", "id", "timescale_service.params", "id"),
+					resource.TestCheckResourceAttr("timescale_service_parameters.params", "parameters.work_mem", "64MB"),
+					resource.TestCheckResourceAttr("timescale_service_parameters.params", "parameters.statement_timeout", "30s"),
+				),
+			},
+			{
+				// Restart-requiring parameter, removes statement_timeout.
+				Config: serviceParametersConfig(`    work_mem = "64MB"
+    max_connections = "150"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timescale_service_parameters.params", "parameters.max_connections", "150"),
+					resource.TestCheckNoResourceAttr("timescale_service_parameters.params", "parameters.statement_timeout"),
+					resource.TestCheckResourceAttr("timescale_service.params", "paused", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestNormalizeParameter(t *testing.T) {
+	cases := []struct {
+		name, value string
+		want        string
+		wantErr     string
+	}{
+		{name: "max_connections", value: "100", want: "100"},
+		{name: "max_connections", value: "10", wantErr: "must be between 25 and 5000"},
+		{name: "max_connections", value: "lots", wantErr: "must be an integer"},
+		{name: "work_mem", value: "64MB", want: "65536"},
+		{name: "work_mem", value: "65536", want: "65536"},
+		{name: "work_mem", value: "4GB", wantErr: "must be between 64kB and 2097151kB"},
+		{name: "work_mem", value: "64mb", wantErr: "invalid unit"},
+		{name: "statement_timeout", value: "30s", want: "30000"},
+		{name: "statement_timeout", value: "2min", want: "120000"},
+		{name: "log_min_duration_statement", value: "-1", want: "-1"},
+		{name: "random_page_cost", value: "1.10", want: "1.1"},
+		{name: "jit", value: "false", want: "off"},
+		{name: "jit", value: "maybe", wantErr: "must be a boolean"},
+		{name: "log_statement", value: "DDL", want: "ddl"},
+		{name: "log_statement", value: "some", wantErr: "must be one of"},
+		{name: "shared_buffers", value: "1GB", wantErr: "unsupported parameter"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name+"="+tc.value, func(t *testing.T) {
+			got, err := normalizeParameter(tc.name, tc.value)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParameterChanges(t *testing.T) {
+	old := map[string]string{"work_mem": "64MB", "max_connections": "100", "jit": "on"}
+	planned := map[string]string{"work_mem": "65536kB", "max_connections": "200", "statement_timeout": "30s"}
+
+	set, reset := parameterChanges(old, planned)
+	require.Equal(t, map[string]string{"max_connections": "200", "statement_timeout": "30s"}, set)
+	require.Equal(t, []string{"jit"}, reset)
+
+	restart, reload := splitByRestart([]string{"statement_timeout", "max_connections", "jit"})
+	require.Equal(t, []string{"max_connections"}, restart)
+	require.Equal(t, []string{"jit", "statement_timeout"}, reload)
+}
+
+func TestParametersFromAPI(t *testing.T) {
+	current := []*tsClient.ServiceParameter{
+		{Name: "work_mem", Value: "64MB"},
+		{Name: "max_connections", Value: "150"},
+		{Name: "jit", Value: "off"},
+		{Name: "lock_timeout", Value: "0", IsDefault: true},
+		{Name: "shared_buffers", Value: "1GB"},
+	}
+
+	managed := map[string]string{"work_mem": "65536", "max_connections": "100"}
+	require.Equal(t,
+		map[string]string{"work_mem": "65536", "max_connections": "150"},
+		parametersFromAPI(managed, current, false),
+		"equivalent values keep their configured spelling and unmanaged parameters are ignored")
+
+	require.Equal(t,
+		map[string]string{"work_mem": "64MB", "max_connections": "150", "jit": "off"},
+		parametersFromAPI(map[string]string{}, current, true),
+		"import adopts supported parameters changed from their default")
+}
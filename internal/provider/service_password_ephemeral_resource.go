@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultPasswordLength = 32
+	minPasswordLength     = 16
+	maxPasswordLength     = 128
+)
+
+// passwordClasses are the character classes of generated passwords. The
+// symbols are URL safe so the password can be used in connection URIs as is.
+var passwordClasses = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"-_.~",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &servicePasswordEphemeralResource{}
+
+// NewServicePasswordEphemeralResource is a helper function to simplify the provider implementation.
+func NewServicePasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &servicePasswordEphemeralResource{}
+}
+
+// servicePasswordEphemeralResource generates a service password. It runs
+// entirely in the provider and needs no API access.
+type servicePasswordEphemeralResource struct{}
+
+type servicePasswordModel struct {
+	Length types.Int64  `tfsdk:"length"`
+	Value  types.String `tfsdk:"value"`
+}
+
+func (r *servicePasswordEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_password"
+}
+
+func (r *servicePasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Generates a strong random password for a service, without persisting it to plan or state.
+A new password is generated on every run: pass it to write-only attributes such as the ` + "`password_wo`" + ` of ` + "`timescale_service_password_rotation`" + `,
+which only apply it when their version changes. Requires Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "Length of the password, between 16 and 128. Defaults to 32.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(minPasswordLength, maxPasswordLength),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The generated password. It contains lower and upper case letters, digits and URL safe symbols.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *servicePasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Trace(ctx, "servicePasswordEphemeralResource.Open")
+	var config servicePasswordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(defaultPasswordLength)
+	if !config.Length.IsNull() {
+		length = config.Length.ValueInt64()
+	}
+	password, err := generatePassword(int(length))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Password", err.Error())
+		return
+	}
+	config.Length = types.Int64Value(length)
+	config.Value = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// generatePassword returns a random password of the given length with at
+// least one character of every class.
+func generatePassword(length int) (string, error) {
+	alphabet := strings.Join(passwordClasses, "")
+	max := big.NewInt(int64(len(alphabet)))
+	for {
+		b := make([]byte, length)
+		for i := range b {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			b[i] = alphabet[n.Int64()]
+		}
+		password := string(b)
+		if hasAllPasswordClasses(password) {
+			return password, nil
+		}
+	}
+}
+
+func hasAllPasswordClasses(password string) bool {
+	for _, class := range passwordClasses {
+		if !strings.ContainsAny(password, class) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &servicePasswordRotationResource{}
+	_ resource.ResourceWithConfigure  = &servicePasswordRotationResource{}
+	_ resource.ResourceWithModifyPlan = &servicePasswordRotationResource{}
+)
+
+const errRotateReadReplica = "service %s is a read replica, it uses the password of its primary service %s: rotate the password of the primary instead"
+
+// NewServicePasswordRotationResource is a helper function to simplify the provider implementation.
+func NewServicePasswordRotationResource() resource.Resource {
+	return &servicePasswordRotationResource{}
+}
+
+// servicePasswordRotationResource resets the password of a service every
+// rotation_days. Like the schedule resources, rotations are detected by the
+// provider at plan time, so Terraform must run periodically.
+type servicePasswordRotationResource struct {
+	client *tsClient.Client
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+type servicePasswordRotationResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ServiceID      types.String `tfsdk:"service_id"`
+	RotationDays   types.Int64  `tfsdk:"rotation_days"`
+	PasswordWo     types.String `tfsdk:"password_wo"`
+	Version        types.Int64  `tfsdk:"version"`
+	RotatedAt      types.String `tfsdk:"rotated_at"`
+	NextRotationAt types.String `tfsdk:"next_rotation_at"`
+	ReadReplicaIDs types.List   `tfsdk:"read_replica_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *servicePasswordRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_password_rotation"
+}
+
+// Schema defines the schema for the resource.
+func (r *servicePasswordRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Rotates the password of a service every ` + "`rotation_days`" + `.
+
+The new password is taken from the write-only ` + "`password_wo`" + ` attribute, typically a ` + "`timescale_service_password`" + ` ephemeral resource,
+so it is never stored in plan or state. The password is sent to the API with the SCRAM password type, and the platform stores it as a
+SCRAM-SHA-256 verifier: the API takes the plaintext password and derives the verifier itself, so none is computed locally. A due rotation is detected whenever a plan is made and shows up as a new ` + "`version`" + `,
+so a password is only rotated by an apply that runs after it falls due. Pass ` + "`version`" + ` to the write-only version attribute of the
+secret store that distributes the password, so that it stores the same password in the same apply.
+
+Read replicas use the password of their primary service: rotate the primary, and its read replicas pick up the new password through
+replication shortly after the apply, without a wait on them.
+Do not set ` + "`password`" + ` or ` + "`password_wo`" + ` on the ` + "`timescale_service`" + ` as well, or the two resources reset each other's password.
+Destroying this resource stops the rotation and leaves the current password in place. Requires Terraform 1.11 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. Same as `service_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service whose password is rotated. It must not be a read replica.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days after which the password is rotated.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password applied when the resource is created and on every rotation, typically the `value` of a `timescale_service_password` ephemeral resource. The value will **not** be stored in Terraform state.",
+				Required:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(minPasswordLength),
+				},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Number of the current password, incremented on every rotation.",
+				Computed:            true,
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "Time of the last rotation, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"next_rotation_at": schema.StringAttribute{
+				MarkdownDescription: "Time from which the next plan rotates the password, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"read_replica_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the read replicas of the service, which receive the rotated password through replication.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *servicePasswordRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "servicePasswordRotationResource.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+	r.now = time.Now
+}
+
+// ModifyPlan plans a rotation when the current password is due.
+func (r *servicePasswordRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.now == nil {
+		return
+	}
+	var plan, state servicePasswordRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// A new service_id replaces the resource, which then starts at version 1.
+	if resp.Diagnostics.HasError() || plan.RotationDays.IsUnknown() || !plan.ServiceID.Equal(state.ServiceID) {
+		return
+	}
+
+	next, err := nextRotation(state.RotatedAt.ValueString(), plan.RotationDays.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotated_at"), "Invalid Rotation Time", err.Error())
+		return
+	}
+	if r.now().Before(next) {
+		plan.ReadReplicaIDs = state.ReadReplicaIDs
+		plan.Version = state.Version
+		plan.RotatedAt = state.RotatedAt
+		plan.NextRotationAt = types.StringValue(next.Format(time.RFC3339))
+	} else {
+		plan.Version = types.Int64Value(state.Version.ValueInt64() + 1)
+		plan.RotatedAt = types.StringUnknown()
+		plan.NextRotationAt = types.StringUnknown()
+		plan.ReadReplicaIDs = types.ListUnknown(types.StringType)
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("The password of service %s will be rotated", plan.ServiceID.ValueString()),
+			fmt.Sprintf("The password was last rotated at %s and is due since %s. Clients using the current password must be given the new one.",
+				state.RotatedAt.ValueString(), next.Format(time.RFC3339)),
+		)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// nextRotation returns the time the password rotated at rotatedAt is due.
+func nextRotation(rotatedAt string, rotationDays int64) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid rotated_at %q: %w", rotatedAt, err)
+	}
+	return t.AddDate(0, 0, int(rotationDays)), nil
+}
+
+// Create sets the first password.
+func (r *servicePasswordRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "servicePasswordRotationResource.Create")
+	var plan servicePasswordRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.ServiceID
+	plan.Version = types.Int64Value(1)
+	if err := r.rotate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Rotate Service Password", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the read replicas of the service.
+func (r *servicePasswordRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "servicePasswordRotationResource.Read")
+	var state servicePasswordRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetService(ctx, state.ServiceID.ValueString()); err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Service not found, removing password rotation from state.", map[string]any{"service_id": state.ServiceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read Service", err.Error())
+		return
+	}
+	replicaIDs, err := r.readReplicaIDs(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Read Replicas", err.Error())
+		return
+	}
+	readReplicaIDs, diags := types.ListValueFrom(ctx, types.StringType, replicaIDs)
+	resp.Diagnostics.Append(diags...)
+	state.ID = state.ServiceID
+	state.ReadReplicaIDs = readReplicaIDs
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update rotates the password when a new version is planned.
+func (r *servicePasswordRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "servicePasswordRotationResource.Update")
+	var plan, state servicePasswordRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.ServiceID
+	if plan.Version.IsUnknown() || plan.Version.Equal(state.Version) {
+		plan.Version, plan.RotatedAt, plan.ReadReplicaIDs = state.Version, state.RotatedAt, state.ReadReplicaIDs
+		next, err := nextRotation(state.RotatedAt.ValueString(), plan.RotationDays.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Rotation Time", err.Error())
+			return
+		}
+		plan.NextRotationAt = types.StringValue(next.Format(time.RFC3339))
+		plan.PasswordWo = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+	if err := r.rotate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Rotate Service Password", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the rotation, the service keeps its current password.
+func (r *servicePasswordRotationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Trace(ctx, "servicePasswordRotationResource.Delete")
+}
+
+// rotate applies the configured password to the service. Read replicas are
+// not waited on: they receive the password through replication from the
+// primary.
+func (r *servicePasswordRotationResource) rotate(ctx context.Context, plan *servicePasswordRotationResourceModel) error {
+	serviceID := plan.ServiceID.ValueString()
+	service, err := r.client.GetService(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	if service.ForkSpec != nil && service.ForkSpec.IsStandby {
+		return fmt.Errorf(errRotateReadReplica, serviceID, service.ForkSpec.ServiceID)
+	}
+
+	tflog.Info(ctx, "rotating service password", map[string]any{"service_id": serviceID, "version": plan.Version.ValueInt64()})
+	// The API derives the SCRAM verifier from the plaintext password.
+	if err := r.client.ResetServicePassword(ctx, serviceID, plan.PasswordWo.ValueString()); err != nil {
+		return err
+	}
+	rotatedAt := r.now().UTC().Truncate(time.Second)
+	plan.PasswordWo = types.StringNull()
+	plan.RotatedAt = types.StringValue(rotatedAt.Format(time.RFC3339))
+	plan.NextRotationAt = types.StringValue(rotatedAt.AddDate(0, 0, int(plan.RotationDays.ValueInt64())).Format(time.RFC3339))
+
+	replicaIDs, err := r.readReplicaIDs(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	plan.ReadReplicaIDs, _ = types.ListValueFrom(ctx, types.StringType, replicaIDs)
+	return nil
+}
+
+// readReplicaIDs lists the read replicas of a service, sorted by ID.
+func (r *servicePasswordRotationResource) readReplicaIDs(ctx context.Context, serviceID string) ([]string, error) {
+	services, err := r.client.GetAllServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return readReplicasOf(services, serviceID), nil
+}
+
+func readReplicasOf(services []*tsClient.Service, serviceID string) []string {
+	ids := []string{}
+	for _, s := range services {
+		if s.ForkSpec != nil && s.ForkSpec.IsStandby && s.ForkSpec.ServiceID == serviceID {
+			ids = append(ids, s.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	seen := map[string]bool{}
+	for range 20 {
+		password, err := generatePassword(minPasswordLength)
+		require.NoError(t, err)
+		require.Len(t, password, minPasswordLength)
+		require.True(t, hasAllPasswordClasses(password), password)
+		require.Equal(t, -1, strings.IndexFunc(password, func(r rune) bool {
+			return !strings.ContainsRune(strings.Join(passwordClasses, ""), r)
+		}))
+		require.False(t, seen[password], "generated the same password twice")
+		seen[password] = true
+	}
+}
+
+func TestPasswordRotationModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&servicePasswordRotationResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := servicePasswordRotationResourceModel{
+		ID:             types.StringValue("svc-1"),
+		ServiceID:      types.StringValue("svc-1"),
+		RotationDays:   types.Int64Value(30),
+		PasswordWo:     types.StringNull(),
+		Version:        types.Int64Value(3),
+		RotatedAt:      types.StringValue("2024-06-01T00:00:00Z"),
+		NextRotationAt: types.StringValue("2024-07-01T00:00:00Z"),
+		ReadReplicaIDs: types.ListNull(types.StringType),
+	}
+	modifyPlan := func(now time.Time, rotationDays int64) servicePasswordRotationResourceModel {
+		r := &servicePasswordRotationResource{now: func() time.Time { return now }}
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		require.False(t, req.State.Set(ctx, &state).HasError())
+		planned := state
+		planned.RotationDays = types.Int64Value(rotationDays)
+		require.False(t, req.Plan.Set(ctx, &planned).HasError())
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		require.False(t, resp.Diagnostics.HasError(), "diags: %v", resp.Diagnostics)
+
+		var got servicePasswordRotationResourceModel
+		require.False(t, resp.Plan.Get(ctx, &got).HasError())
+		return got
+	}
+
+	got := modifyPlan(time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC), 30)
+	require.Equal(t, int64(3), got.Version.ValueInt64())
+	require.Equal(t, "2024-07-01T00:00:00Z", got.NextRotationAt.ValueString())
+
+	got = modifyPlan(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), 30)
+	require.Equal(t, int64(4), got.Version.ValueInt64())
+	require.True(t, got.RotatedAt.IsUnknown())
+
+	// Shortening rotation_days can make the password due right away.
+	got = modifyPlan(time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC), 7)
+	require.Equal(t, int64(4), got.Version.ValueInt64())
+	got = modifyPlan(time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC), 7)
+	require.Equal(t, int64(3), got.Version.ValueInt64())
+	require.Equal(t, "2024-06-08T00:00:00Z", got.NextRotationAt.ValueString())
+}
+
+func TestReadReplicasOf(t *testing.T) {
+	services := []*tsClient.Service{
+		{ID: "primary"},
+		{ID: "rr-2", ForkSpec: &tsClient.ForkSpec{ServiceID: "primary", IsStandby: true}},
+		{ID: "fork", ForkSpec: &tsClient.ForkSpec{ServiceID: "primary"}},
+		{ID: "rr-1", ForkSpec: &tsClient.ForkSpec{ServiceID: "primary", IsStandby: true}},
+		{ID: "rr-other", ForkSpec: &tsClient.ForkSpec{ServiceID: "other", IsStandby: true}},
+	}
+	require.Equal(t, []string{"rr-1", "rr-2"}, readReplicasOf(services, "primary"))
+	require.Empty(t, readReplicasOf(services, "rr-1"))
+}
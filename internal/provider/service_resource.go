@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,10 +23,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
@@ -33,27 +39,44 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &serviceResource{}
 var _ resource.ResourceWithImportState = &serviceResource{}
+var _ resource.ResourceWithModifyPlan = &serviceResource{}
+var _ resource.ResourceWithUpgradeState = &serviceResource{}
 
 const (
 	ErrCreateTimeout              = "Error waiting for service creation"
+	ErrRestartTimeout             = "Error waiting for service restart"
 	ErrUpdateService              = "Error updating service"
 	ErrInvalidAttribute           = "Invalid Attribute Value"
 	errReplicaFromFork            = "cannot create a read replica from a read replica or fork"
 	errReplicaWithHA              = "cannot create a read replica with HA enabled"
 	errUpdateReplicaSource        = "cannot update read replica source"
+	errDemoteReplica              = "a promoted read replica cannot become a read replica again"
+	errPromoteOnCreate            = "promote can only be set on an existing read replica"
+	errPromoteWithNodes           = "read_replica_nodes must be removed when promoting a read replica"
+	errPromoteWithoutPassword     = "set password or password_wo when promoting a read replica, the promoted service no longer follows the password of its source"
 	errAttachExporter             = "error attaching exporter to service"
 	errDetachExporter             = "error detaching exporter form service"
 	errHAFieldConflict            = "cannot set enable_ha_replica as false together with ha_replicas > 0"
 	errHAFieldConflict2           = "cannot set enable_ha_replica as true together with ha_replicas = 0"
 	errSyncReplicaInvalidConfig   = "sync_replicas can only be 1 when ha_replicas = 2"
 	errReadReplicaNodesWithoutSrc = "read_replica_nodes can only be set when read_replica_source is specified"
+	errVersionDowngrade           = "%s cannot be downgraded from %s to %s"
+	errImportIdentifier           = "expected an import identifier of the form <id>, <project_id>/<id> or name:<name>, got: %q"
 	DefaultMilliCPU               = 500
 	DefaultMemoryGB               = 2
+	defaultServiceTimeout         = 45 * time.Minute
 )
 
 var (
 	memorySizes   = []int64{2, 4, 8, 16, 32, 64, 128, 192, 256}
 	milliCPUSizes = []int64{500, 1000, 2000, 4000, 8000, 16000, 32000, 48000, 64000}
+	daysOfWeek    = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+	maintenanceWindowAttrTypes = map[string]attr.Type{
+		"day_of_week":    types.StringType,
+		"start_hour":     types.Int64Type,
+		"duration_hours": types.Int64Type,
+	}
 )
 
 func NewServiceResource() resource.Resource {
@@ -62,7 +85,14 @@ func NewServiceResource() resource.Resource {
 
 // serviceResource defines the resource implementation.
 type serviceResource struct {
-	client *tsClient.Client
+	client      *tsClient.Client
+	defaultTags map[string]string
+	// failOnDisruptiveChanges turns the plan warnings of disruptive changes into errors.
+	failOnDisruptiveChanges bool
+	// catalog provides the compute sizes offered per region.
+	catalog *productCatalog
+	// budget limits the projected compute cost of plans.
+	budget *costBudget
 }
 
 // serviceResourceModel maps the resource schema data.
@@ -73,15 +103,27 @@ type serviceResourceModel struct {
 	MilliCPU                types.Int64    `tfsdk:"milli_cpu"`
 	StorageGB               types.Int64    `tfsdk:"storage_gb"`
 	MemoryGB                types.Int64    `tfsdk:"memory_gb"`
+	ComputeSize             types.String   `tfsdk:"compute_size"`
+	EstimatedHourlyCost     types.Float64  `tfsdk:"estimated_hourly_cost"`
+	EstimatedMonthlyCost    types.Float64  `tfsdk:"estimated_monthly_cost"`
 	Password                types.String   `tfsdk:"password"`
 	PasswordWo              types.String   `tfsdk:"password_wo"`
 	PasswordWoVersion       types.Int64    `tfsdk:"password_wo_version"`
 	Hostname                types.String   `tfsdk:"hostname"`
 	Port                    types.Int64    `tfsdk:"port"`
+	Endpoints               types.List     `tfsdk:"endpoints"`
 	ReplicaHostname         types.String   `tfsdk:"replica_hostname"`
 	ReplicaPort             types.Int64    `tfsdk:"replica_port"`
+	PrimaryNode             types.String   `tfsdk:"primary_node"`
+	Status                  types.String   `tfsdk:"status"`
+	Created                 types.String   `tfsdk:"created"`
+	ReplicaStatus           types.String   `tfsdk:"replica_status"`
+	ForkedFrom              types.String   `tfsdk:"forked_from"`
 	PoolerHostname          types.String   `tfsdk:"pooler_hostname"`
 	PoolerPort              types.Int64    `tfsdk:"pooler_port"`
+	ConnectionURI           types.String   `tfsdk:"connection_uri"`
+	ReplicaConnectionURI    types.String   `tfsdk:"replica_connection_uri"`
+	PoolerConnectionURI     types.String   `tfsdk:"pooler_connection_uri"`
 	Username                types.String   `tfsdk:"username"`
 	RegionCode              types.String   `tfsdk:"region_code"`
 	EnableHAReplica         types.Bool     `tfsdk:"enable_ha_replica"`
@@ -90,12 +132,34 @@ type serviceResourceModel struct {
 	Paused                  types.Bool     `tfsdk:"paused"`
 	ReadReplicaSource       types.String   `tfsdk:"read_replica_source"`
 	ReadReplicaNodes        types.Int64    `tfsdk:"read_replica_nodes"`
+	Promote                 types.Bool     `tfsdk:"promote"`
 	VpcID                   types.Int64    `tfsdk:"vpc_id"`
 	ConnectionPoolerEnabled types.Bool     `tfsdk:"connection_pooler_enabled"`
+	ConnectionPooler        types.Object   `tfsdk:"connection_pooler"`
 	DataTieringEnabled      types.Bool     `tfsdk:"data_tiering_enabled"`
 	EnvironmentTag          types.String   `tfsdk:"environment_tag"`
 	MetricExporterID        types.String   `tfsdk:"metric_exporter_id"`
 	LogExporterID           types.String   `tfsdk:"log_exporter_id"`
+	PgVersion               types.Int64    `tfsdk:"pg_version"`
+	TimescaleDBVersion      types.String   `tfsdk:"timescaledb_version"`
+	MaintenanceWindow       types.Object   `tfsdk:"maintenance_window"`
+	Autoscaling             types.Object   `tfsdk:"autoscaling"`
+	CurrentMilliCPU         types.Int64    `tfsdk:"current_milli_cpu"`
+	CurrentMemoryGB         types.Int64    `tfsdk:"current_memory_gb"`
+	Tags                    types.Map      `tfsdk:"tags"`
+	TagsAll                 types.Map      `tfsdk:"tags_all"`
+	ExpiresAt               types.String   `tfsdk:"expires_at"`
+	TTL                     types.String   `tfsdk:"ttl"`
+
+	RestartOnChange               types.Map  `tfsdk:"restart_on_change"`
+	RestartAfterLogExporterAttach types.Bool `tfsdk:"restart_after_log_exporter_attach"`
+}
+
+// maintenanceWindowModel maps the maintenance_window nested attribute.
+type maintenanceWindowModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	StartHour     types.Int64  `tfsdk:"start_hour"`
+	DurationHours types.Int64  `tfsdk:"duration_hours"`
 }
 
 func (r *serviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -107,6 +171,7 @@ func (r *serviceResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *serviceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	tflog.Trace(ctx, "ServiceResource.Schema")
 	resp.Schema = schema.Schema{
+		Version: serviceSchemaVersion,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `A Service is a TimescaleDB instance.
 Supported providers: AWS and Azure, though there's no VPC support yet on Azure.
@@ -135,17 +200,11 @@ The change has been taken into account but must still be propagated. You can run
 				},
 			},
 			"milli_cpu": schema.Int64Attribute{
-				MarkdownDescription: "Milli CPU",
-				Description:         "Milli CPU",
+				MarkdownDescription: "Milli CPU. With `autoscaling`, the baseline the service is created or resized to, see `current_milli_cpu` for the live size.",
+				Description:         "Milli CPU. With autoscaling, the baseline the service is created or resized to, see current_milli_cpu for the live size.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(DefaultMilliCPU),
-				Validators: []validator.Int64{
-					int64validator.OneOf(milliCPUSizes...),
-					multiplyvalidator.EqualToMultipleOf(250, path.Expressions{
-						path.MatchRoot("memory_gb"),
-					}...),
-				},
 			},
 			"enable_ha_replica": schema.BoolAttribute{
 				MarkdownDescription: "Enable HA Replica (deprecated - use ha_replicas and sync_replicas instead)",
@@ -178,15 +237,17 @@ The change has been taken into account but must still be propagated. You can run
 				},
 			},
 			"read_replica_source": schema.StringAttribute{
-				MarkdownDescription: "If set, this database will be a read replica of the provided source database. The region must be the same as the source, or if omitted will be handled by the provider",
-				Description:         "If set, this database will be a read replica of the provided source database. The region must be the same as the source, or if omitted will be handled by the provider",
+				MarkdownDescription: "If set, this database will be a read replica of the provided source database. The region must be the same as the source, or if omitted will be handled by the provider. It cannot be changed afterwards, see `promote` to detach the replica from its source.",
+				Description:         "If set, this database will be a read replica of the provided source database. The region must be the same as the source, or if omitted will be handled by the provider. It cannot be changed afterwards, see promote to detach the replica from its source.",
 				Optional:            true,
+				DeprecationMessage:  "read_replica_source is deprecated and will be removed in the next major version. Use the timescale_read_replica_set resource instead: existing read replicas can be moved to it without being recreated with a moved block (Terraform 1.8+), for example moved { from = timescale_service.replica, to = timescale_read_replica_set.replica }.",
 			},
 			"read_replica_nodes": schema.Int64Attribute{
 				MarkdownDescription: "Number of read replica nodes (1-10). Only applicable when read_replica_source is set. Defaults to 1.",
 				Description:         "Number of read replica nodes (1-10). Only applicable when read_replica_source is set. Defaults to 1.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  "read_replica_nodes is deprecated and will be removed in the next major version. Use the nodes attribute of the timescale_read_replica_set resource instead, after moving the read replica to it with a moved block.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -194,6 +255,11 @@ The change has been taken into account but must still be propagated. You can run
 					int64validator.Between(1, 10),
 				},
 			},
+			"promote": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` on an existing read replica to promote it to an independent primary, for example for disaster recovery or a region migration. `read_replica_source` can then be kept as a record of the origin or removed. The service then manages its own password: `password` or `password_wo` must be set in the same apply and is applied right after the promotion. The promotion cannot be undone.",
+				Description:         "Set to true on an existing read replica to promote it to an independent primary, for example for disaster recovery or a region migration. read_replica_source can then be kept as a record of the origin or removed. The service then manages its own password: password or password_wo must be set in the same apply and is applied right after the promotion. The promotion cannot be undone.",
+				Optional:            true,
+			},
 			"storage_gb": schema.Int64Attribute{
 				MarkdownDescription: "Deprecated: Storage GB",
 				Description:         "Deprecated: Storage GB",
@@ -201,12 +267,30 @@ The change has been taken into account but must still be propagated. You can run
 				DeprecationMessage:  "This field is ignored. With the new usage-based storage Timescale automatically allocates the disk space needed by your service and you only pay for the disk space you use.",
 			},
 			"memory_gb": schema.Int64Attribute{
-				MarkdownDescription: "Memory GB",
-				Description:         "Memory GB",
+				MarkdownDescription: "Memory GB. With `autoscaling`, the baseline the service is created or resized to, see `current_memory_gb` for the live size.",
+				Description:         "Memory GB. With autoscaling, the baseline the service is created or resized to, see current_memory_gb for the live size.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(DefaultMemoryGB),
-				Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+			},
+			"compute_size": schema.StringAttribute{
+				MarkdownDescription: "Compute size of the service as `<cpu>cpu-<memory>gb`, such as `2cpu-8gb` or `0.5cpu-2gb`. An alternative to `milli_cpu` and `memory_gb`, which are derived from it. The size is validated at plan time against the plans offered in `region_code`, see the `timescale_products` data source. If the product catalog cannot be loaded, the sizes known to this provider version are accepted.",
+				Description:         "Compute size of the service as <cpu>cpu-<memory>gb, such as 2cpu-8gb or 0.5cpu-2gb. An alternative to milli_cpu and memory_gb, which are derived from it. The size is validated at plan time against the plans offered in region_code, see the timescale_products data source. If the product catalog cannot be loaded, the sizes known to this provider version are accepted.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(computeSizeRegex, "must be of the form <cpu>cpu-<memory>gb, such as 2cpu-8gb"),
+				},
+			},
+			"estimated_hourly_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated compute cost of the service per hour, from the price of the plan for its `region_code` and size, times its node count: the primary and its `ha_replicas`, or the `read_replica_nodes` of a read replica. With `autoscaling`, the baseline size is used. Storage is not included. Known at plan time, so cost changes show up in the plan. Null if no plan is found, for example when the product catalog is unavailable.",
+				Description:         "Estimated compute cost of the service per hour, from the price of the plan for its region_code and size, times its node count: the primary and its ha_replicas, or the read_replica_nodes of a read replica. With autoscaling, the baseline size is used. Storage is not included. Known at plan time, so cost changes show up in the plan. Null if no plan is found, for example when the product catalog is unavailable.",
+				Computed:            true,
+			},
+			"estimated_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated compute cost of the service per month of 730 hours, see `estimated_hourly_cost`.",
+				Description:         "Estimated compute cost of the service per month of 730 hours, see estimated_hourly_cost.",
+				Computed:            true,
 			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
@@ -240,8 +324,8 @@ The change has been taken into account but must still be propagated. You can run
 				Optional:            true,
 			},
 			"hostname": schema.StringAttribute{
-				Description:         "The hostname for this service",
-				MarkdownDescription: "The hostname for this service",
+				Description:         "The hostname for this service. Its private hostname once it is attached to a VPC; see endpoints for its public endpoint.",
+				MarkdownDescription: "The hostname for this service. Its private hostname once it is attached to a VPC; see `endpoints` for its public endpoint.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					useStateUnlessToggleChangesString("vpc_id"),
@@ -255,6 +339,38 @@ The change has been taken into account but must still be propagated. You can run
 					useStateUnlessToggleChangesInt64("vpc_id"),
 				},
 			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "All endpoints of this service: the private endpoint in its VPC, if attached to one, and its primary, replica and pooler endpoints.",
+				Description:         "All endpoints of this service: the private endpoint in its VPC, if attached to one, and its primary, replica and pooler endpoints.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					useStateUnlessToggleChangesList("vpc_id", "ha_replicas", "enable_ha_replica", "connection_pooler_enabled"),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "What the endpoint connects to: `primary`, `replica` or `pooler`.",
+							Description:         "What the endpoint connects to: primary, replica or pooler.",
+							Computed:            true,
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the endpoint.",
+							Description:         "Hostname of the endpoint.",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the endpoint.",
+							Description:         "Port of the endpoint.",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "`public` if the endpoint is reachable from the internet, `private` if only from the VPC of the service.",
+							Description:         "public if the endpoint is reachable from the internet, private if only from the VPC of the service.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"replica_hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the HA-Replica of this service.",
 				Description:         "Hostname of the HA-Replica of this service.",
@@ -271,6 +387,43 @@ The change has been taken into account but must still be propagated. You can run
 					useStateUnlessToggleChangesInt64("ha_replicas", "vpc_id"),
 				},
 			},
+			"primary_node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node currently acting as primary. Changes after a switchover or failover between HA nodes, see the `timescale_service_switchover` action.",
+				Description:         "Name of the node currently acting as primary. Changes after a switchover or failover between HA nodes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("ha_replicas"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current status of the service, such as `READY`, `PAUSED` or `CONFIGURING`. Refreshed on every read, so it is only known after apply when the service changes.",
+				Description:         "Current status of the service, such as READY, PAUSED or CONFIGURING. Refreshed on every read, so it is only known after apply when the service changes.",
+				Computed:            true,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Time the service was created.",
+				Description:         "Time the service was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replica_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the HA replicas of the service. Null when it has none.",
+				Description:         "Status of the HA replicas of the service. Null when it has none.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("ha_replicas", "enable_ha_replica"),
+				},
+			},
+			"forked_from": schema.StringAttribute{
+				MarkdownDescription: "ID of the service this service was forked or replicated from. Null for services created from scratch.",
+				Description:         "ID of the service this service was forked or replicated from. Null for services created from scratch.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("promote"),
+				},
+			},
 			"pooler_hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the pooler of this service.",
 				Description:         "Hostname of the pooler of this service.",
@@ -287,6 +440,33 @@ The change has been taken into account but must still be propagated. You can run
 					useStateUnlessToggleChangesInt64("connection_pooler_enabled", "vpc_id"),
 				},
 			},
+			"connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the service, with `sslmode=require` and the default database. Includes `password` when it is in state, so not when `password_wo` is used, see the `timescale_service_credentials` ephemeral resource instead.",
+				Description:         "postgres:// URI of the service, with sslmode=require and the default database. Includes password when it is in state, so not when password_wo is used, see the timescale_service_credentials ephemeral resource instead.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("vpc_id", "password"),
+				},
+			},
+			"replica_connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the HA replica of this service, see `connection_uri`.",
+				Description:         "postgres:// URI of the HA replica of this service, see connection_uri.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("ha_replicas", "vpc_id", "password"),
+				},
+			},
+			"pooler_connection_uri": schema.StringAttribute{
+				MarkdownDescription: "`postgres://` URI of the pooler of this service, see `connection_uri`.",
+				Description:         "postgres:// URI of the pooler of this service, see connection_uri.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessToggleChangesString("connection_pooler_enabled", "vpc_id", "password"),
+				},
+			},
 			"connection_pooler_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Set connection pooler status for this service.",
 				Description:         "Set connection pooler status for this service.",
@@ -297,6 +477,68 @@ The change has been taken into account but must still be propagated. You can run
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"connection_pooler": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the connection pooler. Requires `connection_pooler_enabled = true`. Settings that are not set keep their current value, which is reflected in state. Pool sizes are validated against the connections the service accepts at its size, or at its `autoscaling` minimum.",
+				Description:         "Settings of the connection pooler. Requires connection_pooler_enabled = true. Settings that are not set keep their current value, which is reflected in state. Pool sizes are validated against the connections the service accepts at its size, or at its autoscaling minimum.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"pool_mode": schema.StringAttribute{
+						MarkdownDescription: "When a server connection is returned to the pool: `session`, when the client disconnects, or `transaction`, after each transaction. Changing it disconnects the clients of the pooler.",
+						Description:         "When a server connection is returned to the pool: session, when the client disconnects, or transaction, after each transaction. Changing it disconnects the clients of the pooler.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{stringvalidator.OneOf(poolModes...)},
+					},
+					"default_pool_size": schema.Int64Attribute{
+						MarkdownDescription: "Server connections per database and user pair.",
+						Description:         "Server connections per database and user pair.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"max_client_connections": schema.Int64Attribute{
+						MarkdownDescription: "Client connections the pooler accepts.",
+						Description:         "Client connections the pooler accepts.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"databases": schema.MapNestedAttribute{
+						MarkdownDescription: "Overrides by database name. Unset values inherit the settings above.",
+						Description:         "Overrides by database name. Unset values inherit the settings above.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"pool_mode": schema.StringAttribute{
+									MarkdownDescription: "Pool mode of the database, `session` or `transaction`.",
+									Description:         "Pool mode of the database, session or transaction.",
+									Optional:            true,
+									Validators:          []validator.String{stringvalidator.OneOf(poolModes...)},
+								},
+								"pool_size": schema.Int64Attribute{
+									MarkdownDescription: "Server connections of the database per user.",
+									Description:         "Server connections of the database per user.",
+									Optional:            true,
+									Validators:          []validator.Int64{int64validator.AtLeast(1)},
+								},
+							},
+						},
+					},
+				},
+			},
 			"data_tiering_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Enable [data tiering](https://www.tigerdata.com/docs/learn/data-lifecycle/storage/about-storage-tiers) (low-cost object storage tier on Tiger-managed S3) for this service. Available on Scale and Enterprise plans only. When set to `true`, the OSM functions (`add_tiering_policy`, `tier_chunk`, `remove_tiering_policy`) become available on the service. **Cannot be disabled via Terraform** — to disable, contact Tiger Data support.",
 				Description:         "Enable data tiering (low-cost object storage tier on Tiger-managed S3) for this service. Available on Scale and Enterprise plans only. Cannot be disabled via Terraform.",
@@ -316,6 +558,35 @@ The change has been taken into account but must still be propagated. You can run
 				},
 				Validators: []validator.String{stringvalidator.OneOf("DEV", "PROD")},
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Free-form tags for this service, such as team, cost center or owner. Merged with the provider `default_tags`, taking precedence over them.",
+				Description:         "Free-form tags for this service. Merged with the provider default_tags, taking precedence over them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          tagsValidators,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags of this service, including the ones inherited from the provider `default_tags`.",
+				Description:         "All tags of this service, including the ones inherited from the provider default_tags.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time the service expires at, as an RFC 3339 timestamp such as `2030-01-02T15:04:05Z`. Stored in the `expires_at` tag of the service. Plans warn about services past their expiry, and expired services can be listed with the `timescale_expired_services` data source and deleted with the `timescale_delete_expired_services` action. Conflicts with `ttl`, which sets it when used.",
+				Description:         "Time the service expires at, as an RFC 3339 timestamp such as 2030-01-02T15:04:05Z. Stored in the expires_at tag of the service. Plans warn about services past their expiry, and expired services can be listed with the timescale_expired_services data source and deleted with the timescale_delete_expired_services action. Conflicts with ttl, which sets it when used.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("ttl")),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Time to live of the service, as a Go duration such as `72h`. Sets `expires_at` to the time of the apply plus the ttl, so it is only known after the apply. The expiry is kept until the ttl is changed, which counts it again from the next apply.",
+				Description:         "Time to live of the service, as a Go duration such as 72h. Sets expires_at to the time of the apply plus the ttl, so it is only known after the apply. The expiry is kept until the ttl is changed, which counts it again from the next apply.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
 			"username": schema.StringAttribute{
 				Description:         "The Postgres user for this service",
 				MarkdownDescription: "The Postgres user for this service",
@@ -340,9 +611,8 @@ The change has been taken into account but must still be propagated. You can run
 				Optional:            true,
 			},
 			"paused": schema.BoolAttribute{
-				Description:         `Paused status of the service.`,
-				MarkdownDescription: `Paused status of the service.`,
-				Default:             booldefault.StaticBool(false),
+				Description:         `Paused status of the service. Leave unset when the service is managed by a timescale_service_schedule.`,
+				MarkdownDescription: "Paused status of the service. Leave unset when the service is managed by a `timescale_service_schedule`, so the scheduled state is kept.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Bool{
@@ -358,9 +628,141 @@ The change has been taken into account but must still be propagated. You can run
 				Description: `The Log Exporter ID attached to this service, only supported in AWS for now.
 				WARNING: To complete the logs exporter attachment, a service restart is required.`,
 				MarkdownDescription: `The Log Exporter ID attached to this service, only supported in AWS for now.
-				WARNING: To complete the logs exporter attachment, a service restart is required.`,
+				WARNING: To complete the logs exporter attachment, a service restart is required. Set ` + "`restart_after_log_exporter_attach`" + ` to do it automatically.`,
 				Optional: true,
 			},
+			"restart_after_log_exporter_attach": schema.BoolAttribute{
+				Description:         "Restart the service after attaching a log exporter, completing the attachment. Defaults to false.",
+				MarkdownDescription: "Restart the service after attaching a log exporter, completing the attachment. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"restart_on_change": schema.MapAttribute{
+				Description:         "Arbitrary map of values that, when changed, restarts the service during apply. Not used when the service is created.",
+				MarkdownDescription: "Arbitrary map of values that, when changed, restarts the service during apply, e.g. a hash of restart-requiring settings. Not used when the service is created. With Terraform 1.14 or later the `timescale_service_restart` action can be used instead.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"pg_version": schema.Int64Attribute{
+				Description:         "Postgres major version of this service. If not set, the platform default is used and automatic upgrades are reflected in state. Increasing it triggers an in-place major upgrade, which causes downtime. Downgrades are not supported.",
+				MarkdownDescription: "Postgres major version of this service. If not set, the platform default is used and automatic upgrades are reflected in state. Increasing it triggers an in-place major upgrade, which causes downtime. Downgrades are not supported.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timescaledb_version": schema.StringAttribute{
+				Description:         "TimescaleDB extension version of this service, such as 2.17.2. If not set, automatic upgrades are reflected in state. New services are upgraded to it once created. Increasing it triggers an in-place extension upgrade, which restarts the service. Downgrades are not supported.",
+				MarkdownDescription: "TimescaleDB extension version of this service, such as `2.17.2`. If not set, automatic upgrades are reflected in state. New services are upgraded to it once created. Increasing it triggers an in-place extension upgrade, which restarts the service. Downgrades are not supported.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+\.\d+\.\d+$`), "must be a version such as 2.17.2"),
+				},
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description:         "Weekly window, in UTC, during which the platform may apply maintenance to this service. If not set, the window assigned by the platform is reflected in state.",
+				MarkdownDescription: "Weekly window, in UTC, during which the platform may apply maintenance to this service. If not set, the window assigned by the platform is reflected in state.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						Description:         "Day of the week the window starts on, such as SUNDAY.",
+						MarkdownDescription: "Day of the week the window starts on, such as `SUNDAY`.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(daysOfWeek...)},
+					},
+					"start_hour": schema.Int64Attribute{
+						Description:         "Hour of the day (0-23, UTC) the window starts at.",
+						MarkdownDescription: "Hour of the day (0-23, UTC) the window starts at.",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.Between(0, 23)},
+					},
+					"duration_hours": schema.Int64Attribute{
+						Description:         "Length of the window in hours (1-24).",
+						MarkdownDescription: "Length of the window in hours (1-24).",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.Between(1, 24)},
+					},
+				},
+			},
+			"autoscaling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Lets the platform resize the compute of the service between the given bounds as its CPU utilization changes. While it is set, the live size is reported in `current_milli_cpu` and `current_memory_gb`, and `milli_cpu` and `memory_gb` only record the baseline: changing them does not resize the service. Remove the block to disable autoscaling, the service is then resized back to the baseline.",
+				Description:         "Lets the platform resize the compute of the service between the given bounds as its CPU utilization changes. While it is set, the live size is reported in current_milli_cpu and current_memory_gb, and milli_cpu and memory_gb only record the baseline: changing them does not resize the service. Remove the block to disable autoscaling, the service is then resized back to the baseline.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"min_milli_cpu": schema.Int64Attribute{
+						MarkdownDescription: "Smallest Milli CPU the service is scaled down to.",
+						Description:         "Smallest Milli CPU the service is scaled down to.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(milliCPUSizes...),
+							multiplyvalidator.EqualToMultipleOf(250, path.MatchRelative().AtParent().AtName("min_memory_gb")),
+						},
+					},
+					"min_memory_gb": schema.Int64Attribute{
+						MarkdownDescription: "Memory GB of the smallest size.",
+						Description:         "Memory GB of the smallest size.",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+					},
+					"max_milli_cpu": schema.Int64Attribute{
+						MarkdownDescription: "Largest Milli CPU the service is scaled up to.",
+						Description:         "Largest Milli CPU the service is scaled up to.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(milliCPUSizes...),
+							multiplyvalidator.EqualToMultipleOf(250, path.MatchRelative().AtParent().AtName("max_memory_gb")),
+						},
+					},
+					"max_memory_gb": schema.Int64Attribute{
+						MarkdownDescription: "Memory GB of the largest size.",
+						Description:         "Memory GB of the largest size.",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+					},
+					"scale_up_threshold": schema.Int64Attribute{
+						MarkdownDescription: "CPU utilization, in percent, above which the service is scaled up. Defaults to `80`.",
+						Description:         "CPU utilization, in percent, above which the service is scaled up. Defaults to 80.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(defaultScaleUpThreshold),
+						Validators:          []validator.Int64{int64validator.Between(1, 100)},
+					},
+					"scale_down_threshold": schema.Int64Attribute{
+						MarkdownDescription: "CPU utilization, in percent, below which the service is scaled down. Must be lower than `scale_up_threshold`. Defaults to `30`.",
+						Description:         "CPU utilization, in percent, below which the service is scaled down. Must be lower than scale_up_threshold. Defaults to 30.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(defaultScaleDownThreshold),
+						Validators:          []validator.Int64{int64validator.Between(0, 99)},
+					},
+				},
+			},
+			"current_milli_cpu": schema.Int64Attribute{
+				MarkdownDescription: "Milli CPU the service currently runs with. Differs from `milli_cpu` when `autoscaling` resized the service.",
+				Description:         "Milli CPU the service currently runs with. Differs from milli_cpu when autoscaling resized the service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessToggleChangesInt64("milli_cpu", "memory_gb", "autoscaling"),
+				},
+			},
+			"current_memory_gb": schema.Int64Attribute{
+				MarkdownDescription: "Memory GB the service currently runs with. Differs from `memory_gb` when `autoscaling` resized the service.",
+				Description:         "Memory GB the service currently runs with. Differs from memory_gb when autoscaling resized the service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessToggleChangesInt64("milli_cpu", "memory_gb", "autoscaling"),
+				},
+			},
 		},
 	}
 }
@@ -372,18 +774,22 @@ func (r *serviceResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*tsClient.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *tsClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.defaultTags = data.defaultTags
+	r.failOnDisruptiveChanges = data.failOnDisruptiveChanges
+	r.catalog = data.catalog
+	r.budget = data.budget
 }
 
 func validateHAConfiguration(plan serviceResourceModel) error {
@@ -405,12 +811,37 @@ func validateHAConfiguration(plan serviceResourceModel) error {
 	return nil
 }
 
+// validateReplicaSourceUpdate checks that read_replica_source only changes
+// when a read replica is promoted: it is then allowed to be removed.
+func validateReplicaSourceUpdate(state, plan serviceResourceModel) error {
+	from, to := state.ReadReplicaSource.ValueString(), plan.ReadReplicaSource.ValueString()
+	promoted := from != "" && state.Promote.ValueBool()
+	switch {
+	case promoted && to != "" && !plan.Promote.ValueBool():
+		return errors.New(errDemoteReplica)
+	case from == to:
+		return nil
+	case to == "" && (promoted || plan.Promote.ValueBool()):
+		return nil
+	case from != "" && to == "":
+		return fmt.Errorf("%s, set promote = true to turn the read replica into an independent primary", errUpdateReplicaSource)
+	default:
+		return errors.New(errUpdateReplicaSource)
+	}
+}
+
+// isPromotion reports whether the update promotes a read replica.
+func isPromotion(state, plan serviceResourceModel) bool {
+	return state.ReadReplicaSource.ValueString() != "" && !state.Promote.ValueBool() && plan.Promote.ValueBool()
+}
+
 func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "ServiceResource.Create")
 	var plan serviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(r.applyExpiry(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -448,6 +879,13 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		EnableConnectionPooler: plan.ConnectionPoolerEnabled.ValueBool(),
 		EnvironmentTag:         plan.EnvironmentTag.ValueString(),
 	}
+	if !plan.PgVersion.IsNull() && !plan.PgVersion.IsUnknown() {
+		request.PgVersion = strconv.FormatInt(plan.PgVersion.ValueInt64(), 10)
+	}
+	resp.Diagnostics.Append(plan.TagsAll.ElementsAs(ctx, &request.Tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readReplicaSource := plan.ReadReplicaSource.ValueString()
 	if readReplicaSource != "" {
@@ -493,7 +931,7 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// If creating a read replica, retry on backup availability errors
 	if readReplicaSource != "" {
-		response, err = r.createReadReplicaWithRetry(ctx, request)
+		response, err = createReadReplicaWithRetry(ctx, r.client, request)
 	} else {
 		response, err = r.client.CreateService(ctx, request)
 	}
@@ -553,6 +991,22 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	// The create API only takes pg_version, so a configured extension version
+	// different from the platform default is reached with an upgrade. Read
+	// replicas follow the version of their primary.
+	if !plan.TimescaleDBVersion.IsNull() && !plan.TimescaleDBVersion.IsUnknown() && readReplicaSource == "" &&
+		plan.TimescaleDBVersion.ValueString() != service.ServiceSpec.TimescaleDBVersion {
+		if err := r.client.UpgradeService(ctx, service.ID, tsClient.UpgradeServiceRequest{TimescaleDBVersion: plan.TimescaleDBVersion.ValueString()}); err != nil {
+			resp.Diagnostics.AddError("Failed to upgrade service", fmt.Sprintf("Unable to set timescaledb_version to %s, got error: %s", plan.TimescaleDBVersion.ValueString(), err))
+			return
+		}
+		service, err = r.waitForServiceReadiness(ctx, service.ID, plan.Timeouts)
+		if err != nil {
+			resp.Diagnostics.AddError(ErrCreateTimeout, fmt.Sprintf("error occurred while waiting for service upgrade, got error: %s", err))
+			return
+		}
+	}
+
 	// Exporters
 	if !plan.MetricExporterID.IsNull() {
 		err := r.client.AttachMetricExporter(ctx, service.ID, plan.MetricExporterID.ValueString())
@@ -573,10 +1027,18 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 			resp.Diagnostics.AddError(errAttachExporter, err.Error())
 			return
 		}
-		service, err = r.client.GetService(ctx, service.ID)
-		if err != nil {
-			resp.Diagnostics.AddError(errAttachExporter, "unable to refresh service after attaching exporter")
-			return
+		if plan.RestartAfterLogExporterAttach.ValueBool() {
+			service, err = r.restartService(ctx, service.ID, plan.Timeouts)
+			if err != nil {
+				resp.Diagnostics.AddError(ErrRestartTimeout, fmt.Sprintf("error occurred while restarting service after attaching log exporter, got error: %s", err))
+				return
+			}
+		} else {
+			service, err = r.client.GetService(ctx, service.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(errAttachExporter, "unable to refresh service after attaching exporter")
+				return
+			}
 		}
 	}
 
@@ -595,7 +1057,59 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	resourceModel := serviceToResource(resp.Diagnostics, service, plan)
+	if !plan.MaintenanceWindow.IsNull() && !plan.MaintenanceWindow.IsUnknown() {
+		window, diags := maintenanceWindowFromModel(ctx, plan.MaintenanceWindow)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetMaintenanceWindow(ctx, service.ID, window); err != nil {
+			resp.Diagnostics.AddError("Failed to set maintenance window", err.Error())
+			return
+		}
+		service, err = r.client.GetService(ctx, service.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to set maintenance window", "unable to refresh service after setting the maintenance window")
+			return
+		}
+	}
+
+	if plan.ConnectionPoolerEnabled.ValueBool() && !plan.ConnectionPooler.IsNull() && !plan.ConnectionPooler.IsUnknown() {
+		settings, diags := poolerSettingsFromModel(ctx, plan.ConnectionPooler)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetConnectionPoolerSettings(ctx, service.ID, settings); err != nil {
+			resp.Diagnostics.AddError(errSetPoolerSettings, err.Error())
+			return
+		}
+		service, err = r.client.GetService(ctx, service.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(errSetPoolerSettings, "unable to refresh service after configuring the connection pooler")
+			return
+		}
+	}
+
+	if !plan.Autoscaling.IsNull() && !plan.Autoscaling.IsUnknown() {
+		settings, diags := autoscalingFromModel(ctx, plan.Autoscaling)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetServiceAutoscaling(ctx, service.ID, settings); err != nil {
+			resp.Diagnostics.AddError(errSetAutoscaling, err.Error())
+			return
+		}
+		service, err = r.client.GetService(ctx, service.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(errSetAutoscaling, "unable to refresh service after enabling autoscaling")
+			return
+		}
+	}
+
+	resourceModel := serviceToResource(&resp.Diagnostics, service, plan, r.defaultTags)
+	r.applyEstimatedCost(ctx, &resourceModel, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("error updating terraform state %v", resp.Diagnostics.Errors()))
@@ -616,13 +1130,13 @@ func (r *serviceResource) validateCreateReadReplicaRequest(ctx context.Context,
 }
 
 // createReadReplicaWithRetry attempts to create a read replica with retry logic for backup availability errors.
-func (r *serviceResource) createReadReplicaWithRetry(ctx context.Context, request tsClient.CreateServiceRequest) (*tsClient.CreateServiceResponse, error) {
-	tflog.Trace(ctx, "ServiceResource.createReadReplicaWithRetry")
+func createReadReplicaWithRetry(ctx context.Context, client *tsClient.Client, request tsClient.CreateServiceRequest) (*tsClient.CreateServiceResponse, error) {
+	tflog.Trace(ctx, "createReadReplicaWithRetry")
 
 	var response *tsClient.CreateServiceResponse
 
 	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
-		resp, err := r.client.CreateService(ctx, request)
+		resp, err := client.CreateService(ctx, request)
 		if err != nil {
 			errMsg := err.Error()
 			if strings.Contains(errMsg, "doesn't yet have any backups or snapshots available") {
@@ -648,13 +1162,26 @@ func (r *serviceResource) createReadReplicaWithRetry(ctx context.Context, reques
 func (r *serviceResource) waitForServiceReadiness(ctx context.Context, id string, timeouts timeouts.Value) (*tsClient.Service, error) {
 	tflog.Trace(ctx, "ServiceResource.waitForServiceReadiness")
 
-	defaultTimeout := 45 * time.Minute
-	timeout, diags := timeouts.Create(ctx, defaultTimeout)
+	timeout, diags := timeouts.Create(ctx, defaultServiceTimeout)
 	if diags != nil && diags.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("found errs %v", diags.Errors()))
 		return nil, fmt.Errorf("unable to get timeout from config %v", diags.Errors())
 	}
+	return waitForServiceReadiness(ctx, r.client, id, timeout)
+}
+
+// restartService restarts the service and waits until it is ready again.
+func (r *serviceResource) restartService(ctx context.Context, id string, timeouts timeouts.Value) (*tsClient.Service, error) {
+	if err := r.client.RestartService(ctx, id); err != nil {
+		return nil, err
+	}
+	return r.waitForServiceReadiness(ctx, id, timeouts)
+}
 
+// waitForServiceReadiness polls the service until it settles as READY or PAUSED.
+// It is shared by every resource whose changes put the service through a
+// reconfiguration or restart.
+func waitForServiceReadiness(ctx context.Context, client *tsClient.Client, id string, timeout time.Duration) (*tsClient.Service, error) {
 	conf := retry.StateChangeConf{
 		Pending:                   []string{"QUEUED", "CONFIGURING", "UNSTABLE", "PAUSING", "RESUMING"},
 		Target:                    []string{"READY", "PAUSED"},
@@ -664,7 +1191,7 @@ func (r *serviceResource) waitForServiceReadiness(ctx context.Context, id string
 		NotFoundChecks:            40,
 		ContinuousTargetOccurence: 1,
 		Refresh: func() (result interface{}, state string, err error) {
-			s, err := r.client.GetService(ctx, id)
+			s, err := client.GetService(ctx, id)
 			if err != nil {
 				tflog.Error(ctx, "error polling service status", map[string]interface{}{"service_id": id, "error": err.Error()})
 				return nil, "", err
@@ -711,7 +1238,8 @@ func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
 	}
-	resourceModel := serviceToResource(resp.Diagnostics, service, state)
+	resourceModel := serviceToResource(&resp.Diagnostics, service, state, r.defaultTags)
+	r.refreshEstimatedCost(ctx, &resourceModel, state)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel)...)
 	if resp.Diagnostics.HasError() {
@@ -726,6 +1254,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(r.applyExpiry(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -737,23 +1266,48 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	readReplicaSource := plan.ReadReplicaSource.ValueString()
-	if readReplicaSource != state.ReadReplicaSource.ValueString() {
-		resp.Diagnostics.AddError(ErrUpdateService, errUpdateReplicaSource)
+	if err := validateReplicaSourceUpdate(state, plan); err != nil {
+		resp.Diagnostics.AddError(ErrUpdateService, err.Error())
 		return
 	}
+	// A promoted service keeps read_replica_source only as a record of its origin.
+	isReadReplica := plan.ReadReplicaSource.ValueString() != "" && !plan.Promote.ValueBool()
+	promote := isPromotion(state, plan)
 
-	if readReplicaSource != "" && ((!plan.EnableHAReplica.IsNull() && plan.EnableHAReplica.ValueBool()) || (!plan.HAReplicas.IsNull() && plan.HAReplicas.ValueInt64() > 0)) {
+	if isReadReplica && ((!plan.EnableHAReplica.IsNull() && plan.EnableHAReplica.ValueBool()) || (!plan.HAReplicas.IsNull() && plan.HAReplicas.ValueInt64() > 0)) {
 		resp.Diagnostics.AddError(ErrUpdateService, errReplicaWithHA)
 		return
 	}
 
 	// Validate read_replica_nodes is only used with read_replica_source
-	if !plan.ReadReplicaNodes.IsNull() && !plan.ReadReplicaNodes.IsUnknown() && readReplicaSource == "" {
-		resp.Diagnostics.AddError(ErrInvalidAttribute, errReadReplicaNodesWithoutSrc)
+	if !plan.ReadReplicaNodes.IsNull() && !plan.ReadReplicaNodes.IsUnknown() && !isReadReplica {
+		if promote {
+			resp.Diagnostics.AddError(ErrInvalidAttribute, errPromoteWithNodes)
+		} else {
+			resp.Diagnostics.AddError(ErrInvalidAttribute, errReadReplicaNodesWithoutSrc)
+		}
 		return
 	}
 
+	// Promotion ////////////////////////////////////////
+	// Runs first so that the rest of the changes apply to an independent primary.
+	if promote {
+		tflog.Info(ctx, "Promoting read replica: "+serviceID)
+		if err := r.client.PromoteReadReplica(ctx, serviceID); err != nil {
+			resp.Diagnostics.AddError("Failed to promote read replica", err.Error())
+			return
+		}
+		if _, err := r.waitForServiceReadiness(ctx, serviceID, plan.Timeouts); err != nil {
+			resp.Diagnostics.AddError(ErrCreateTimeout, fmt.Sprintf("error occurred while waiting for read replica promotion, got error: %s", err))
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{
+			"promote":             plan.Promote,
+			"read_replica_source": plan.ReadReplicaSource,
+			"read_replica_nodes":  plan.ReadReplicaNodes,
+		})
+	}
+
 	if plan.Paused != state.Paused {
 		status := "ACTIVE"
 		if plan.Paused.ValueBool() {
@@ -763,6 +1317,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			resp.Diagnostics.AddError("Failed to toggle service", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"paused": plan.Paused})
 	}
 
 	// Connection pooler ////////////////////////////////////////
@@ -771,6 +1326,19 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			resp.Diagnostics.AddError("Failed to toggle connection pooler", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"connection_pooler_enabled": plan.ConnectionPoolerEnabled})
+	}
+	if plan.ConnectionPoolerEnabled.ValueBool() && !plan.ConnectionPooler.IsNull() && !plan.ConnectionPooler.IsUnknown() && !plan.ConnectionPooler.Equal(state.ConnectionPooler) {
+		settings, diags := poolerSettingsFromModel(ctx, plan.ConnectionPooler)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetConnectionPoolerSettings(ctx, serviceID, settings); err != nil {
+			resp.Diagnostics.AddError(errSetPoolerSettings, err.Error())
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"connection_pooler": plan.ConnectionPooler})
 	}
 	// Data tiering /////////////////////////////////////////////
 	// Disabling tiering is not supported via the Tiger Cloud API (no UI button
@@ -788,12 +1356,38 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			resp.Diagnostics.AddError("Failed to toggle data tiering", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"data_tiering_enabled": plan.DataTieringEnabled})
 	}
 	if plan.EnvironmentTag != state.EnvironmentTag {
 		if err := r.client.SetEnvironmentTag(ctx, serviceID, plan.EnvironmentTag.ValueString()); err != nil {
 			resp.Diagnostics.AddError("Failed to set environment tag", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"environment_tag": plan.EnvironmentTag})
+	}
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		var tags map[string]string
+		resp.Diagnostics.Append(plan.TagsAll.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetServiceTags(ctx, serviceID, tags); err != nil {
+			resp.Diagnostics.AddError("Failed to set tags", err.Error())
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"tags": plan.Tags, "tags_all": plan.TagsAll})
+	}
+	if !plan.MaintenanceWindow.IsNull() && !plan.MaintenanceWindow.IsUnknown() && !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		window, diags := maintenanceWindowFromModel(ctx, plan.MaintenanceWindow)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetMaintenanceWindow(ctx, serviceID, window); err != nil {
+			resp.Diagnostics.AddError("Failed to set maintenance window", err.Error())
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"maintenance_window": plan.MaintenanceWindow})
 	}
 
 	// HA Replica ////////////////////////////////////////
@@ -801,14 +1395,9 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	var planReplicaCount, stateReplicaCount int64
 	var planSyncReplicaCount, stateSyncReplicaCount int64
 
-	// Calculate current state replica count and sync replica count
-	if !state.HAReplicas.IsNull() {
-		stateReplicaCount = state.HAReplicas.ValueInt64()
-		stateSyncReplicaCount = state.SyncReplicas.ValueInt64()
-	} else if !state.EnableHAReplica.IsNull() && state.EnableHAReplica.ValueBool() {
-		stateReplicaCount = 1
-		stateSyncReplicaCount = 0
-	}
+	// The state always has the replica counts, see upgradeHAReplicas.
+	stateReplicaCount = state.HAReplicas.ValueInt64()
+	stateSyncReplicaCount = state.SyncReplicas.ValueInt64()
 
 	// Calculate planned replica count and sync replica count
 	if !plan.HAReplicas.IsNull() {
@@ -820,16 +1409,21 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update the replica count if it has changed (for non-read-replica services)
-	if readReplicaSource == "" && (planReplicaCount != stateReplicaCount || planSyncReplicaCount != stateSyncReplicaCount) {
+	if !isReadReplica && (planReplicaCount != stateReplicaCount || planSyncReplicaCount != stateSyncReplicaCount) {
 		if err := r.client.SetReplicaCount(ctx, serviceID, int(planReplicaCount), int(planSyncReplicaCount)); err != nil {
 			resp.Diagnostics.AddError("Failed to update HA replicas", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{
+			"ha_replicas":       plan.HAReplicas,
+			"sync_replicas":     plan.SyncReplicas,
+			"enable_ha_replica": plan.EnableHAReplica,
+		})
 	}
 
 	// Read Replica Nodes ////////////////////////////////////////
 	// For read replicas, update the replica count based on read_replica_nodes
-	if readReplicaSource != "" && !plan.ReadReplicaNodes.Equal(state.ReadReplicaNodes) {
+	if isReadReplica && !plan.ReadReplicaNodes.Equal(state.ReadReplicaNodes) {
 		readReplicaNodes := plan.ReadReplicaNodes.ValueInt64()
 		if readReplicaNodes == 0 {
 			readReplicaNodes = 1 // Default to 1 node
@@ -839,6 +1433,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			resp.Diagnostics.AddError("Failed to update read replica nodes", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"read_replica_nodes": plan.ReadReplicaNodes})
 	}
 
 	// VPC ////////////////////////////////////////
@@ -849,6 +1444,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError("Failed to detach service from VPC", err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"vpc_id": types.Int64Null()})
 		}
 		// if plan.VpcId is known, it must be attached
 		if !plan.VpcID.IsNull() && !plan.VpcID.IsUnknown() {
@@ -856,6 +1452,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError("Failed to attach service to VPC", err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"vpc_id": plan.VpcID})
 		}
 	}
 
@@ -864,6 +1461,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			resp.Diagnostics.AddError("Failed to rename a service", err.Error())
 			return
 		}
+		saveProgress(ctx, resp, map[string]attr.Value{"name": plan.Name})
 	}
 
 	// Exporters
@@ -875,6 +1473,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError(errDetachExporter, err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"metric_exporter_id": types.StringNull()})
 		}
 		if !plan.MetricExporterID.IsNull() && !plan.MetricExporterID.IsUnknown() {
 			err := r.client.AttachMetricExporter(ctx, serviceID, plan.MetricExporterID.ValueString())
@@ -882,9 +1481,12 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError(errAttachExporter, err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"metric_exporter_id": plan.MetricExporterID})
 		}
 	}
 
+	// A restart is deferred until every other change has been applied.
+	restartRequested := !plan.RestartOnChange.IsNull() && !plan.RestartOnChange.Equal(state.RestartOnChange)
 	if !plan.LogExporterID.Equal(state.LogExporterID) {
 		// Detach old and attach new
 		if !state.LogExporterID.IsNull() && !state.LogExporterID.IsUnknown() {
@@ -893,6 +1495,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError(errDetachExporter, err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"log_exporter_id": types.StringNull()})
 		}
 		if !plan.LogExporterID.IsNull() && !plan.LogExporterID.IsUnknown() {
 			err := r.client.AttachGenericExporter(ctx, serviceID, plan.LogExporterID.ValueString())
@@ -900,10 +1503,27 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError(errAttachExporter, err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"log_exporter_id": plan.LogExporterID})
+			restartRequested = restartRequested || plan.RestartAfterLogExporterAttach.ValueBool()
 		}
 	}
 
-	{
+	// Compute ////////////////////////////////////////
+	// While autoscaling is enabled the platform owns the compute size and
+	// milli_cpu/memory_gb only record the baseline. Disabling it resizes the
+	// service from its live size back to the baseline.
+	currentMilliCPU, currentMemoryGB := state.MilliCPU, state.MemoryGB
+	if !state.Autoscaling.IsNull() {
+		currentMilliCPU, currentMemoryGB = state.CurrentMilliCPU, state.CurrentMemoryGB
+		if plan.Autoscaling.IsNull() {
+			if err := r.client.SetServiceAutoscaling(ctx, serviceID, tsClient.AutoscalingSettings{Enabled: false}); err != nil {
+				resp.Diagnostics.AddError(errSetAutoscaling, err.Error())
+				return
+			}
+			saveProgress(ctx, resp, map[string]attr.Value{"autoscaling": plan.Autoscaling})
+		}
+	}
+	if plan.Autoscaling.IsNull() || state.Autoscaling.IsNull() {
 		isResizeRequested := false
 		const noop = "0" // Compute and storage could be resized separately. Setting value to 0 means a no-op.
 		resizeConfig := tsClient.ResourceConfig{
@@ -911,7 +1531,7 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			MemoryGB: noop,
 		}
 
-		if !plan.MilliCPU.Equal(state.MilliCPU) || !plan.MemoryGB.Equal(state.MemoryGB) {
+		if !plan.MilliCPU.Equal(currentMilliCPU) || !plan.MemoryGB.Equal(currentMemoryGB) {
 			isResizeRequested = true
 			resizeConfig.MilliCPU = strconv.FormatInt(plan.MilliCPU.ValueInt64(), 10)
 			resizeConfig.MemoryGB = strconv.FormatInt(plan.MemoryGB.ValueInt64(), 10)
@@ -922,8 +1542,21 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 				resp.Diagnostics.AddError("Failed to resize an instance", err.Error())
 				return
 			}
+			saveProgress(ctx, resp, map[string]attr.Value{"milli_cpu": plan.MilliCPU, "memory_gb": plan.MemoryGB})
 		}
 	}
+	if !plan.Autoscaling.IsNull() && !plan.Autoscaling.Equal(state.Autoscaling) {
+		settings, diags := autoscalingFromModel(ctx, plan.Autoscaling)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.SetServiceAutoscaling(ctx, serviceID, settings); err != nil {
+			resp.Diagnostics.AddError(errSetAutoscaling, err.Error())
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"autoscaling": plan.Autoscaling, "milli_cpu": plan.MilliCPU, "memory_gb": plan.MemoryGB})
+	}
 
 	service, err := r.waitForServiceReadiness(ctx, serviceID, plan.Timeouts)
 	if err != nil {
@@ -931,6 +1564,38 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Versions ////////////////////////////////////////
+	// Upgrades restart the service, so they run once every other change has
+	// settled and are followed by their own readiness wait.
+	upgrade := tsClient.UpgradeServiceRequest{}
+	if !plan.PgVersion.IsNull() && !plan.PgVersion.IsUnknown() && !plan.PgVersion.Equal(state.PgVersion) {
+		upgrade.PgVersion = strconv.FormatInt(plan.PgVersion.ValueInt64(), 10)
+	}
+	if !plan.TimescaleDBVersion.IsNull() && !plan.TimescaleDBVersion.IsUnknown() && !plan.TimescaleDBVersion.Equal(state.TimescaleDBVersion) {
+		upgrade.TimescaleDBVersion = plan.TimescaleDBVersion.ValueString()
+	}
+	if upgrade != (tsClient.UpgradeServiceRequest{}) {
+		if err := r.client.UpgradeService(ctx, serviceID, upgrade); err != nil {
+			resp.Diagnostics.AddError("Failed to upgrade service", err.Error())
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"pg_version": plan.PgVersion, "timescaledb_version": plan.TimescaleDBVersion})
+		service, err = r.waitForServiceReadiness(ctx, serviceID, plan.Timeouts)
+		if err != nil {
+			resp.Diagnostics.AddError(ErrCreateTimeout, fmt.Sprintf("error occurred while waiting for service upgrade, got error: %s", err))
+			return
+		}
+	}
+
+	if restartRequested {
+		service, err = r.restartService(ctx, serviceID, plan.Timeouts)
+		if err != nil {
+			resp.Diagnostics.AddError(ErrRestartTimeout, fmt.Sprintf("error occurred while restarting service, got error: %s", err))
+			return
+		}
+		saveProgress(ctx, resp, map[string]attr.Value{"restart_on_change": plan.RestartOnChange})
+	}
+
 	// Update Password
 	var passwordWo types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
@@ -938,16 +1603,18 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if !passwordWo.IsNull() && passwordWo.ValueString() != "" && readReplicaSource == "" {
+	// A promoted replica stops following the password of its source, so the
+	// configured password is applied even when unchanged.
+	if !passwordWo.IsNull() && passwordWo.ValueString() != "" && !isReadReplica {
 		// Write-only password: trigger update when password_wo_version changes
-		if !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		if !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) || promote {
 			err := r.client.ResetServicePassword(ctx, serviceID, passwordWo.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError("Failed to update password", fmt.Sprintf("Unable to update password, got error: %s", err))
 				return
 			}
 		}
-	} else if !plan.Password.Equal(state.Password) && !plan.Password.IsNull() && readReplicaSource == "" {
+	} else if (!plan.Password.Equal(state.Password) || promote) && !plan.Password.IsNull() && !plan.Password.IsUnknown() && !isReadReplica {
 		err := r.client.ResetServicePassword(ctx, serviceID, plan.Password.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to update password", fmt.Sprintf("Unable to update password, got error: %s", err))
@@ -955,7 +1622,8 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	resources := serviceToResource(resp.Diagnostics, service, plan)
+	resources := serviceToResource(&resp.Diagnostics, service, plan, r.defaultTags)
+	r.applyEstimatedCost(ctx, &resources, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, resources)...)
 
 	if resp.Diagnostics.HasError() {
@@ -964,6 +1632,19 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 }
 
+// saveProgress records the attributes of a successfully applied update step in
+// state. A later failing step then leaves an accurate record, and the next apply
+// only retries the remaining steps. Values that are not fully known are left at
+// their prior state.
+func saveProgress(ctx context.Context, resp *resource.UpdateResponse, attrs map[string]attr.Value) {
+	for name, value := range attrs {
+		if v, err := value.ToTerraformValue(ctx); err != nil || !v.IsFullyKnown() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
 func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Trace(ctx, "ServiceResource.Delete")
 	var data serviceResourceModel
@@ -993,11 +1674,219 @@ func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ModifyPlan rejects changes the platform does not support, such as version
+// downgrades, and summarizes the impact of the remaining ones.
+func (r *serviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.planExpiry(ctx, req, resp)
+	resp.Diagnostics.Append(r.planTagsAll(ctx, req, resp)...)
+	r.planComputeSize(ctx, req, resp)
+	r.planEstimatedCost(ctx, resp)
+	r.planBudget(ctx, resp)
+	resp.Diagnostics.Append(validateAutoscalingPlan(ctx, resp.Plan)...)
+	r.planConnectionPooler(ctx, req, resp)
+
+	if req.State.Raw.IsNull() {
+		var promote types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("promote"), &promote)...)
+		if promote.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("promote"), ErrInvalidAttribute, errPromoteOnCreate)
+		}
+		// The remaining checks compare against an existing service
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state, plan serviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported service has no password in state, it stays unset until one is configured.
+	var configuredPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &configuredPassword)...)
+	if state.Password.IsNull() && configuredPassword.IsNull() && plan.Password.IsUnknown() {
+		plan.Password = types.StringNull()
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	}
+
+	if isPromotion(state, plan) {
+		r.planPromotion(ctx, req, resp)
+	} else if plan.Promote.ValueBool() && !state.Promote.ValueBool() && state.ReadReplicaSource.ValueString() == "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("promote"), "Nothing to promote", "The service is not a read replica, promote has no effect.")
+	}
+
+	if !plan.PgVersion.IsNull() && !plan.PgVersion.IsUnknown() && !state.PgVersion.IsNull() && plan.PgVersion.ValueInt64() < state.PgVersion.ValueInt64() {
+		from := strconv.FormatInt(state.PgVersion.ValueInt64(), 10)
+		to := strconv.FormatInt(plan.PgVersion.ValueInt64(), 10)
+		resp.Diagnostics.AddAttributeError(path.Root("pg_version"), ErrInvalidAttribute, fmt.Sprintf(errVersionDowngrade, "pg_version", from, to))
+	}
+
+	if !plan.TimescaleDBVersion.IsNull() && !plan.TimescaleDBVersion.IsUnknown() && !state.TimescaleDBVersion.IsNull() {
+		from := state.TimescaleDBVersion.ValueString()
+		to := plan.TimescaleDBVersion.ValueString()
+		if compareVersions(to, from) < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timescaledb_version"), ErrInvalidAttribute, fmt.Sprintf(errVersionDowngrade, "timescaledb_version", from, to))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Summarize the impact of the remaining changes.
+	reportDisruptions(&resp.Diagnostics, state.Name.ValueString(), classifyServiceChanges(state, plan), r.failOnDisruptiveChanges)
+}
+
+// planPromotion plans the promotion of a read replica: the replica node count
+// goes away, the HA replica endpoints are only known after the promotion and
+// the configured password takes over from the one of the source.
+func (r *serviceResource) planPromotion(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var nodes, haReplicas types.Int64
+	var password, passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("read_replica_nodes"), &nodes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ha_replicas"), &haReplicas)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !nodes.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("read_replica_nodes"), ErrInvalidAttribute, errPromoteWithNodes)
+		return
+	}
+	if password.IsNull() && passwordWo.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("promote"), ErrInvalidAttribute, errPromoteWithoutPassword)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("read_replica_nodes"), types.Int64Null())...)
+	if haReplicas.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ha_replicas"), types.Int64Unknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replica_hostname"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replica_port"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replica_connection_uri"), types.StringUnknown())...)
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("promote"),
+		"Read replica will be promoted",
+		"The service stops replicating from its source and becomes an independent primary. This cannot be undone.",
+	)
+}
+
+// compareVersions compares two dotted numeric versions and returns -1, 0 or 1.
+// Missing or non-numeric components are treated as 0.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int64
+		if i < len(as) {
+			x, _ = strconv.ParseInt(as[i], 10, 64)
+		}
+		if i < len(bs) {
+			y, _ = strconv.ParseInt(bs[i], 10, 64)
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// ImportState imports a service by its ID, by <project_id>/<id>, or by
+// name:<name>. The remaining attributes are filled in by Read.
 func (r *serviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveServiceImportID(ctx, req.ID, r.client.GetProjectID(), r.client.GetAllServices)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import Service", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// resolveServiceImportID returns the service ID an import identifier refers to.
+func resolveServiceImportID(ctx context.Context, importID, projectID string, listServices func(context.Context) ([]*tsClient.Service, error)) (string, error) {
+	if name, ok := strings.CutPrefix(importID, "name:"); ok {
+		if name == "" {
+			return "", fmt.Errorf(errImportIdentifier, importID)
+		}
+		services, err := listServices(ctx)
+		if err != nil {
+			return "", err
+		}
+		var ids []string
+		for _, s := range services {
+			if s.Name == name {
+				ids = append(ids, s.ID)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no service named %q found in project %s", name, projectID)
+		case 1:
+			return ids[0], nil
+		default:
+			sort.Strings(ids)
+			return "", fmt.Errorf("%d services are named %q (%s), import one of them by ID", len(ids), name, strings.Join(ids, ", "))
+		}
+	}
+	if project, id, ok := strings.Cut(importID, "/"); ok {
+		if project == "" || id == "" || strings.Contains(id, "/") {
+			return "", fmt.Errorf(errImportIdentifier, importID)
+		}
+		if project != projectID {
+			return "", fmt.Errorf("service %s belongs to project %s, but the provider is configured for project %s", id, project, projectID)
+		}
+		return id, nil
+	}
+	if importID == "" {
+		return "", fmt.Errorf(errImportIdentifier, importID)
+	}
+	return importID, nil
+}
+
+// planTagsAll sets tags_all to the provider default tags merged with the resource
+// tags and the expiry tag.
+func (r *serviceResource) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var tags types.Map
+	diags := req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)
+	if diags.HasError() {
+		return diags
+	}
+	if tags.IsUnknown() {
+		return resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))
+	}
+	var configured map[string]string
+	if !tags.IsNull() {
+		diags.Append(tags.ElementsAs(ctx, &configured, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	var expiresAt types.String
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if diags.HasError() {
+		return diags
+	}
+	if expiresAt.IsUnknown() {
+		return resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))
+	}
+	tagsAll, d := types.MapValueFrom(ctx, types.StringType, withExpiryTag(mergeTags(r.defaultTags, configured), expiresAt))
+	diags.Append(d...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+	return diags
 }
 
-func serviceToResource(diag diag.Diagnostics, s *tsClient.Service, state serviceResourceModel) serviceResourceModel {
+func serviceToResource(diags *diag.Diagnostics, s *tsClient.Service, state serviceResourceModel, defaultTags map[string]string) serviceResourceModel {
 	hasPooler := s.ServiceSpec.PoolerEnabled
 	hasDataTiering := s.DataTieringSettings != nil && s.DataTieringSettings.Enabled
 	replicaCount := s.Resources[0].Spec.ReplicaCount
@@ -1033,16 +1922,45 @@ func serviceToResource(diag diag.Diagnostics, s *tsClient.Service, state service
 		Paused:                  types.BoolValue(s.Status == "PAUSED" || s.Status == "PAUSING"),
 		ReadReplicaSource:       state.ReadReplicaSource,
 		ReadReplicaNodes:        readReplicaNodes,
+		Promote:                 state.Promote,
 		ConnectionPoolerEnabled: types.BoolValue(hasPooler),
+		ConnectionPooler:        poolerSettingsToModel(hasPooler, s.ServiceSpec.PoolerSettings),
 		DataTieringEnabled:      types.BoolValue(hasDataTiering),
 		EnableHAReplica:         types.BoolNull(),
 		Hostname:                types.StringNull(),
 		Port:                    types.Int64Null(),
 		ReplicaHostname:         types.StringNull(),
 		ReplicaPort:             types.Int64Null(),
+		PrimaryNode:             types.StringNull(),
+		Status:                  types.StringValue(s.Status),
+		Created:                 types.StringValue(s.Created),
+		ReplicaStatus:           types.StringNull(),
+		ForkedFrom:              types.StringNull(),
 		PoolerHostname:          types.StringNull(),
 		PoolerPort:              types.Int64Null(),
-	}
+		ConnectionURI:           types.StringNull(),
+		ReplicaConnectionURI:    types.StringNull(),
+		PoolerConnectionURI:     types.StringNull(),
+		PgVersion:               types.Int64Null(),
+		TimescaleDBVersion:      types.StringNull(),
+		MaintenanceWindow:       types.ObjectNull(maintenanceWindowAttrTypes),
+		Autoscaling:             autoscalingToModel(s.Autoscaling),
+		CurrentMilliCPU:         types.Int64Value(s.Resources[0].Spec.MilliCPU),
+		CurrentMemoryGB:         types.Int64Value(s.Resources[0].Spec.MemoryGB),
+
+		RestartOnChange:               state.RestartOnChange,
+		RestartAfterLogExporterAttach: state.RestartAfterLogExporterAttach,
+	}
+	if model.RestartAfterLogExporterAttach.IsNull() {
+		model.RestartAfterLogExporterAttach = types.BoolValue(false)
+	}
+	// The autoscaler moves the live size away from the configured baseline,
+	// which is kept so that it does not show up as a diff.
+	if !model.Autoscaling.IsNull() && !state.MilliCPU.IsNull() && !state.MilliCPU.IsUnknown() && !state.MemoryGB.IsNull() && !state.MemoryGB.IsUnknown() {
+		model.MilliCPU = state.MilliCPU
+		model.MemoryGB = state.MemoryGB
+	}
+	model.ComputeSize = computeSizeValue(computeSize{MilliCPU: model.MilliCPU.ValueInt64(), MemoryGB: model.MemoryGB.ValueInt64()}, state.ComputeSize)
 
 	// If the user was using the deprecated has_ha_replica field, populate it from the API for backwards compatibility
 	if !state.EnableHAReplica.IsNull() {
@@ -1055,20 +1973,50 @@ func serviceToResource(diag diag.Diagnostics, s *tsClient.Service, state service
 
 	if s.VPCEndpoint != nil {
 		if vpcID, err := strconv.ParseInt(s.VPCEndpoint.VPCId, 10, 64); err != nil {
-			diag.AddError("Parse Error", "could not parse vpcID")
+			diags.AddError("Parse Error", "could not parse vpcID")
 		} else {
 			model.VpcID = types.Int64Value(vpcID)
 		}
 	}
+	tags := map[string]string{}
 	if s.Metadata != nil {
 		model.EnvironmentTag = types.StringValue(s.Metadata.Environment)
+		tags = s.Metadata.TagMap()
 	} else {
 		model.EnvironmentTag = types.StringNull()
 	}
+	tagsAll, d := types.MapValueFrom(context.Background(), types.StringType, tags)
+	diags.Append(d...)
+	model.TagsAll = tagsAll
+	model.TTL = state.TTL
+	model.ExpiresAt = types.StringNull()
+	if expiresAt, ok := tags[expiryTagKey]; ok {
+		model.ExpiresAt = types.StringValue(expiresAt)
+		// The expiry tag is only part of tags when it is configured there.
+		if _, configured := state.Tags.Elements()[expiryTagKey]; !configured {
+			tags = maps.Clone(tags)
+			delete(tags, expiryTagKey)
+		}
+	}
+	resourceTags, d := resourceTags(tags, defaultTags, state.Tags)
+	diags.Append(d...)
+	model.Tags = resourceTags
 	if isReadReplica {
 		model.ReadReplicaSource = types.StringValue(s.ForkSpec.ServiceID)
 	}
 
+	if s.PrimaryNode != "" {
+		model.PrimaryNode = types.StringValue(s.PrimaryNode)
+	}
+	if s.ReplicaStatus != "" {
+		model.ReplicaStatus = types.StringValue(s.ReplicaStatus)
+	}
+	if s.ForkSpec != nil && s.ForkSpec.ServiceID != "" {
+		model.ForkedFrom = types.StringValue(s.ForkSpec.ServiceID)
+	}
+
+	endpoints := listServiceEndpoints(s)
+	model.Endpoints = endpointsToList(endpoints)
 	if s.Endpoints != nil {
 		if s.Endpoints.Primary != nil && s.Endpoints.Primary.Host != "" {
 			model.Hostname = types.StringValue(s.Endpoints.Primary.Host)
@@ -1085,6 +2033,9 @@ func serviceToResource(diag diag.Diagnostics, s *tsClient.Service, state service
 			model.PoolerPort = types.Int64Value(int64(s.Endpoints.Pooler.Port))
 		}
 	}
+	model.ConnectionURI = endpointURI(s, model.Password.ValueString(), model.Hostname, model.Port)
+	model.ReplicaConnectionURI = endpointURI(s, model.Password.ValueString(), model.ReplicaHostname, model.ReplicaPort)
+	model.PoolerConnectionURI = endpointURI(s, model.Password.ValueString(), model.PoolerHostname, model.PoolerPort)
 
 	if s.ServiceSpec.MetricExporterUUID != nil {
 		model.MetricExporterID = types.StringValue(*s.ServiceSpec.MetricExporterUUID)
@@ -1092,6 +2043,33 @@ func serviceToResource(diag diag.Diagnostics, s *tsClient.Service, state service
 	if s.ServiceSpec.GenericExporterID != nil {
 		model.LogExporterID = types.StringValue(*s.ServiceSpec.GenericExporterID)
 	}
+	if s.ServiceSpec.PgVersion != "" {
+		if pgVersion, err := strconv.ParseInt(s.ServiceSpec.PgVersion, 10, 64); err != nil {
+			diags.AddError("Parse Error", "could not parse pgVersion")
+		} else {
+			model.PgVersion = types.Int64Value(pgVersion)
+		}
+	}
+	if s.ServiceSpec.TimescaleDBVersion != "" {
+		model.TimescaleDBVersion = types.StringValue(s.ServiceSpec.TimescaleDBVersion)
+	}
+	if s.MaintenanceWindow != nil {
+		model.MaintenanceWindow = types.ObjectValueMust(maintenanceWindowAttrTypes, map[string]attr.Value{
+			"day_of_week":    types.StringValue(s.MaintenanceWindow.DayOfWeek),
+			"start_hour":     types.Int64Value(s.MaintenanceWindow.StartHour),
+			"duration_hours": types.Int64Value(s.MaintenanceWindow.DurationHours),
+		})
+	}
 
 	return model
 }
+
+func maintenanceWindowFromModel(ctx context.Context, obj types.Object) (tsClient.MaintenanceWindow, diag.Diagnostics) {
+	var m maintenanceWindowModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	return tsClient.MaintenanceWindow{
+		DayOfWeek:     m.DayOfWeek.ValueString(),
+		StartHour:     m.StartHour.ValueInt64(),
+		DurationHours: m.DurationHours.ValueInt64(),
+	}, diags
+}
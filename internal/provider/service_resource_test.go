@@ -3,7 +3,9 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -34,6 +36,9 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "id"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "password"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "hostname"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "connection_uri"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "status", "READY"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "created"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "username"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "port"),
 					resource.TestCheckResourceAttr("timescale_service.resource", "milli_cpu", "500"),
@@ -45,6 +50,8 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.resource", "data_tiering_enabled", "false"),
 					resource.TestCheckResourceAttr("timescale_service.resource", "environment_tag", "DEV"),
 					resource.TestCheckNoResourceAttr("timescale_service.resource", "vpc_id"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "pg_version"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "timescaledb_version"),
 				),
 			},
 			// Do a compute resize
@@ -55,6 +62,25 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.resource", "memory_gb", "4"),
 				),
 			},
+			// Enable autoscaling around the current size
+			{
+				Config: getServiceConfig(t, config.WithAutoscaling(&Autoscaling{MinMilliCPU: 500, MinMemoryGB: 2, MaxMilliCPU: 2000, MaxMemoryGB: 8})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timescale_service.resource", "autoscaling.max_milli_cpu", "2000"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "autoscaling.scale_up_threshold", "80"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "milli_cpu", "1000"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "current_milli_cpu"),
+				),
+			},
+			// Disable autoscaling, the service is back at its configured size
+			{
+				Config: getServiceConfig(t, config.WithAutoscaling(nil)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("timescale_service.resource", "autoscaling"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "current_milli_cpu", "1000"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "current_memory_gb", "4"),
+				),
+			},
 			// Update service name
 			{
 				Config: getServiceConfig(t, config.WithName("service resource test update")),
@@ -69,6 +95,24 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.resource", "environment_tag", "PROD"),
 				),
 			},
+			// Set maintenance window
+			{
+				Config: getServiceConfig(t, config.WithMaintenanceWindow("SUNDAY", 3, 2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timescale_service.resource", "maintenance_window.day_of_week", "SUNDAY"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "maintenance_window.start_hour", "3"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "maintenance_window.duration_hours", "2"),
+				),
+			},
+			// Set tags
+			{
+				Config: getServiceConfig(t, config.WithTags(map[string]string{"team": "analytics", "cost_center": "42"})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timescale_service.resource", "tags.%", "2"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "tags.team", "analytics"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "tags_all.cost_center", "42"),
+				),
+			},
 			// Enable pooler
 			{
 				Config: getServiceConfig(t, config.WithPooler(true)),
@@ -76,6 +120,17 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.resource", "connection_pooler_enabled", "true"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "pooler_hostname"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "pooler_port"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "connection_pooler.pool_mode"),
+				),
+			},
+			// Configure the pooler
+			{
+				Config: getServiceConfig(t, config.WithPoolerSettings(&PoolerSettings{PoolMode: "session", DefaultPoolSize: 20, DatabasePoolSizes: map[string]int64{"tsdb": 40}})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timescale_service.resource", "connection_pooler.pool_mode", "session"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "connection_pooler.default_pool_size", "20"),
+					resource.TestCheckResourceAttr("timescale_service.resource", "connection_pooler.databases.tsdb.pool_size", "40"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "connection_pooler.max_client_connections"),
 				),
 			},
 			// Enable data tiering (requires Scale or Enterprise plan on the test project).
@@ -93,6 +148,7 @@ func TestServiceResource_Default_Success(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.resource", "enable_ha_replica", "true"),
 					resource.TestCheckResourceAttr("timescale_service.resource", "ha_replicas", "1"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "replica_hostname"),
+					resource.TestCheckResourceAttrSet("timescale_service.resource", "primary_node"),
 					resource.TestCheckResourceAttrSet("timescale_service.resource", "replica_port"),
 				),
 			},
@@ -322,6 +378,70 @@ func TestServiceResource_Read_Replica_Nodes_Validation(t *testing.T) {
 	})
 }
 
+func TestServiceResource_Read_Replica_Promote(t *testing.T) {
+	const (
+		primaryName = "primary"
+		replicaName = "read_replica"
+		primaryFQID = "timescale_service." + primaryName
+		replicaFQID = "timescale_service." + replicaName
+	)
+	var (
+		primaryConfig = &ServiceConfig{
+			ResourceName: primaryName,
+			Name:         "primary-for-promotion",
+		}
+		replicaConfig = &ServiceConfig{
+			ResourceName:      replicaName,
+			Name:              "replica-to-promote",
+			ReadReplicaSource: primaryFQID + ".id",
+		}
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error: promote when creating a read replica
+			{
+				Config:      getServiceConfig(t, primaryConfig, (&ServiceConfig{ResourceName: "new_replica", ReadReplicaSource: primaryFQID + ".id"}).WithPromote(true)),
+				ExpectError: regexp.MustCompile(errPromoteOnCreate),
+			},
+			{
+				Config: getServiceConfig(t, primaryConfig, replicaConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(replicaFQID, "read_replica_nodes", "1"),
+				),
+			},
+			// Error: promote without a password of its own
+			{
+				Config:      getServiceConfig(t, primaryConfig, replicaConfig.WithPromote(true)),
+				ExpectError: regexp.MustCompile(errPromoteWithoutPassword),
+			},
+			// Promote and take over password management
+			{
+				Config: getServiceConfig(t, primaryConfig, replicaConfig.WithPromote(true).WithPasswordWo("PromotedPassword123!", 1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(replicaFQID, "promote", "true"),
+					resource.TestCheckNoResourceAttr(replicaFQID, "read_replica_nodes"),
+					resource.TestCheckResourceAttrPair(replicaFQID, "read_replica_source", primaryFQID, "id"),
+				),
+			},
+			// Error: turning the promoted service back into a replica
+			{
+				Config:      getServiceConfig(t, primaryConfig, replicaConfig.WithPromote(false)),
+				ExpectError: regexp.MustCompile(errDemoteReplica),
+			},
+			// The origin can be dropped, and HA enabled like on any primary
+			{
+				Config: getServiceConfig(t, primaryConfig, replicaConfig.WithReadReplica("").WithHAReplicasCount(1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(replicaFQID, "read_replica_source"),
+					resource.TestCheckResourceAttr(replicaFQID, "ha_replicas", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestServiceResource_HA_Validation(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -428,6 +548,16 @@ func TestServiceResource_CustomConf(t *testing.T) {
 				}),
 				ExpectError: regexp.MustCompile(ErrInvalidAttribute),
 			},
+			// Invalid compute size for the region
+			{
+				Config: providerConfig + `
+				resource "timescale_service" "invalid" {
+					name         = "test-service-conf"
+					compute_size = "3cpu-12gb"
+					region_code  = "eu-central-1"
+				}`,
+				ExpectError: regexp.MustCompile(`closest available sizes`),
+			},
 			// Invalid conf storage invalid region
 			{
 				Config: newServiceCustomConfig("invalid", ServiceConfig{
@@ -448,6 +578,9 @@ func TestServiceResource_CustomConf(t *testing.T) {
 					resource.TestCheckResourceAttr("timescale_service.custom", "name", "test-service-conf"),
 					resource.TestCheckResourceAttr("timescale_service.custom", "password", "test123456789"),
 					resource.TestCheckResourceAttr("timescale_service.custom", "region_code", "eu-central-1"),
+					resource.TestCheckResourceAttr("timescale_service.custom", "compute_size", "1cpu-4gb"),
+					resource.TestCheckResourceAttrSet("timescale_service.custom", "estimated_hourly_cost"),
+					resource.TestCheckResourceAttrSet("timescale_service.custom", "estimated_monthly_cost"),
 					resource.TestCheckNoResourceAttr("timescale_service.custom", "vpc_id"),
 				),
 			},
@@ -455,6 +588,35 @@ func TestServiceResource_CustomConf(t *testing.T) {
 	})
 }
 
+func TestServiceResource_TTL(t *testing.T) {
+	config := func(ttl string) string {
+		return providerConfig + fmt.Sprintf(`
+				resource "timescale_service" "ttl" {
+					name = "test-service-ttl"
+					ttl  = %q
+				}`, ttl)
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config("2h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("timescale_service.ttl", "expires_at"),
+					resource.TestCheckResourceAttrPair("timescale_service.ttl", "expires_at", "timescale_service.ttl", "tags_all.expires_at"),
+					resource.TestCheckNoResourceAttr("timescale_service.ttl", "tags.expires_at"),
+				),
+			},
+			// The expiry is kept while the ttl is unchanged
+			{
+				Config:   config("2h"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestServiceResource_Import(t *testing.T) {
 	config := newServiceConfig(ServiceConfig{Name: "test-import"})
 	resource.Test(t, resource.TestCase{
@@ -489,6 +651,23 @@ func TestServiceResource_Import(t *testing.T) {
 				resource "timescale_service" "resource_import" {}
 				`,
 			},
+			// Import the same service by <project_id>/<id>.
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					r, ok := state.RootModule().Resources["timescale_service.resource"]
+					if !ok {
+						return "", errors.New("import ID not found")
+					}
+					return os.Getenv("TF_VAR_ts_project_id") + "/" + r.Primary.ID, nil
+				},
+				ResourceName: "timescale_service.resource_import",
+				Config: config + `
+				resource "timescale_service" "resource_import" {}
+				`,
+			},
 			// Import the resource. This step compares the replica resource attributes for "test" defined above with the imported resource
 			// "test_import" defined in the config for this step. This check is done by specifying the ImportStateVerify configuration option.
 			{
@@ -575,6 +754,7 @@ type ServiceConfig struct {
 	VpcID             int64
 	ReadReplicaSource string
 	ReadReplicaNodes  *int64
+	Promote           bool
 	Pooler            bool
 	DataTiering       bool
 	Environment       string
@@ -583,6 +763,29 @@ type ServiceConfig struct {
 	PasswordWoVersion *int64
 	MetricExporterID  string
 	LogExporterID     string
+	MaintenanceWindow *MaintenanceWindow
+	Autoscaling       *Autoscaling
+	PoolerSettings    *PoolerSettings
+	Tags              map[string]string
+}
+
+type PoolerSettings struct {
+	PoolMode          string
+	DefaultPoolSize   int64
+	DatabasePoolSizes map[string]int64
+}
+
+type Autoscaling struct {
+	MinMilliCPU int64
+	MinMemoryGB int64
+	MaxMilliCPU int64
+	MaxMemoryGB int64
+}
+
+type MaintenanceWindow struct {
+	DayOfWeek     string
+	StartHour     int64
+	DurationHours int64
 }
 
 type Timeouts struct {
@@ -660,6 +863,35 @@ func (c *ServiceConfig) WithReadReplicaNodes(nodes int64) *ServiceConfig {
 	return c
 }
 
+func (c *ServiceConfig) WithPromote(promote bool) *ServiceConfig {
+	c.Promote = promote
+	return c
+}
+
+func (c *ServiceConfig) WithMaintenanceWindow(dayOfWeek string, startHour, durationHours int64) *ServiceConfig {
+	c.MaintenanceWindow = &MaintenanceWindow{
+		DayOfWeek:     dayOfWeek,
+		StartHour:     startHour,
+		DurationHours: durationHours,
+	}
+	return c
+}
+
+func (c *ServiceConfig) WithAutoscaling(autoscaling *Autoscaling) *ServiceConfig {
+	c.Autoscaling = autoscaling
+	return c
+}
+
+func (c *ServiceConfig) WithPoolerSettings(settings *PoolerSettings) *ServiceConfig {
+	c.PoolerSettings = settings
+	return c
+}
+
+func (c *ServiceConfig) WithTags(tags map[string]string) *ServiceConfig {
+	c.Tags = tags
+	return c
+}
+
 func (c *ServiceConfig) WithPasswordWo(password string, version int64) *ServiceConfig {
 	c.PasswordWo = password
 	c.PasswordWoVersion = &version
@@ -684,6 +916,9 @@ func (c *ServiceConfig) String(t *testing.T) string {
 	if c.ReadReplicaNodes != nil {
 		write("read_replica_nodes = %d \n", *c.ReadReplicaNodes)
 	}
+	if c.Promote {
+		write("promote = %t \n", c.Promote)
+	}
 	if c.EnableHAReplica != nil {
 		write("enable_ha_replica = %t \n", *c.EnableHAReplica)
 	}
@@ -720,6 +955,42 @@ func (c *ServiceConfig) String(t *testing.T) string {
 	if c.PasswordWoVersion != nil {
 		write("password_wo_version = %d \n", *c.PasswordWoVersion)
 	}
+	if c.MaintenanceWindow != nil {
+		write("maintenance_window = { \n day_of_week = %q \n start_hour = %d \n duration_hours = %d \n } \n",
+			c.MaintenanceWindow.DayOfWeek, c.MaintenanceWindow.StartHour, c.MaintenanceWindow.DurationHours)
+	}
+	if c.Autoscaling != nil {
+		write("autoscaling = { \n min_milli_cpu = %d \n min_memory_gb = %d \n max_milli_cpu = %d \n max_memory_gb = %d \n } \n",
+			c.Autoscaling.MinMilliCPU, c.Autoscaling.MinMemoryGB, c.Autoscaling.MaxMilliCPU, c.Autoscaling.MaxMemoryGB)
+	}
+	if c.PoolerSettings != nil {
+		write("connection_pooler = { \n pool_mode = %q \n default_pool_size = %d \n", c.PoolerSettings.PoolMode, c.PoolerSettings.DefaultPoolSize)
+		if c.PoolerSettings.DatabasePoolSizes != nil {
+			names := make([]string, 0, len(c.PoolerSettings.DatabasePoolSizes))
+			for name := range c.PoolerSettings.DatabasePoolSizes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			write("databases = { \n")
+			for _, name := range names {
+				write("%q = { pool_size = %d } \n", name, c.PoolerSettings.DatabasePoolSizes[name])
+			}
+			write("} \n")
+		}
+		write("} \n")
+	}
+	if c.Tags != nil {
+		keys := make([]string, 0, len(c.Tags))
+		for k := range c.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		write("tags = { \n")
+		for _, k := range keys {
+			write("%q = %q \n", k, c.Tags[k])
+		}
+		write("} \n")
+	}
 	write(`
 			milli_cpu  = %d
 			memory_gb  = %d
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// newTestService returns a minimal API service that serviceToResource can map.
+func newTestService() *tsClient.Service {
+	return &tsClient.Service{
+		ID:         "svc-1",
+		ProjectID:  "proj",
+		Name:       "test",
+		Status:     "READY",
+		RegionCode: "us-east-1",
+		Resources:  []tsClient.ResourceSpec{{ID: "res-1"}},
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"2.17.2", "2.17.2", 0},
+		{"2.17.2", "2.17.10", -1},
+		{"2.18.0", "2.17.10", 1},
+		{"2.17", "2.17.0", 0},
+		{"3.0.0", "2.99.99", 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			require.Equal(t, tc.want, compareVersions(tc.a, tc.b))
+		})
+	}
+}
+
+func TestServiceToResource_ReportsParseErrors(t *testing.T) {
+	s := newTestService()
+	var diags diag.Diagnostics
+	serviceToResource(&diags, s, serviceResourceModel{}, nil)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+
+	s.ServiceSpec.PgVersion = "seventeen"
+	model := serviceToResource(&diags, s, serviceResourceModel{}, nil)
+	require.True(t, diags.HasError())
+	require.True(t, model.PgVersion.IsNull())
+}
+
+func TestServiceToResource_MaintenanceWindow(t *testing.T) {
+	s := newTestService()
+	model := serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.True(t, model.MaintenanceWindow.IsNull(), "missing window must map to a typed null object")
+
+	s.MaintenanceWindow = &tsClient.MaintenanceWindow{DayOfWeek: "SUNDAY", StartHour: 3, DurationHours: 2}
+	model = serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	window, diags := maintenanceWindowFromModel(context.Background(), model.MaintenanceWindow)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.Equal(t, *s.MaintenanceWindow, window)
+}
+
+func TestServiceToResource_Autoscaling(t *testing.T) {
+	s := newTestService()
+	s.Resources[0].Spec.MilliCPU = 2000
+	s.Resources[0].Spec.MemoryGB = 8
+	baseline := serviceResourceModel{MilliCPU: types.Int64Value(1000), MemoryGB: types.Int64Value(4)}
+
+	model := serviceToResource(&diag.Diagnostics{}, s, baseline, nil)
+	require.True(t, model.Autoscaling.IsNull())
+	require.Equal(t, int64(2000), model.MilliCPU.ValueInt64(), "without autoscaling the live size is the configured size")
+
+	s.Autoscaling = &tsClient.AutoscalingSettings{Enabled: true, MinMilliCPU: 1000, MinMemoryGB: 4, MaxMilliCPU: 4000, MaxMemoryGB: 16, ScaleUpThreshold: 80, ScaleDownThreshold: 30}
+	model = serviceToResource(&diag.Diagnostics{}, s, baseline, nil)
+	require.Equal(t, int64(1000), model.MilliCPU.ValueInt64(), "the baseline is kept while autoscaling")
+	require.Equal(t, int64(4), model.MemoryGB.ValueInt64())
+	require.Equal(t, int64(2000), model.CurrentMilliCPU.ValueInt64())
+	require.Equal(t, int64(8), model.CurrentMemoryGB.ValueInt64())
+	settings, diags := autoscalingFromModel(context.Background(), model.Autoscaling)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.Equal(t, *s.Autoscaling, settings)
+
+	model = serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.Equal(t, int64(2000), model.MilliCPU.ValueInt64(), "on import the live size is used as the baseline")
+}
+
+func TestValidateAutoscaling(t *testing.T) {
+	settings := tsClient.AutoscalingSettings{Enabled: true, MinMilliCPU: 1000, MinMemoryGB: 4, MaxMilliCPU: 4000, MaxMemoryGB: 16, ScaleUpThreshold: 80, ScaleDownThreshold: 30}
+	require.NoError(t, validateAutoscaling(settings, types.Int64Value(2000), types.Int64Value(8)))
+	require.NoError(t, validateAutoscaling(settings, types.Int64Unknown(), types.Int64Unknown()))
+	require.ErrorContains(t, validateAutoscaling(settings, types.Int64Value(500), types.Int64Value(2)), "milli_cpu (500)")
+	require.ErrorContains(t, validateAutoscaling(settings, types.Int64Value(4000), types.Int64Value(32)), "memory_gb (32)")
+
+	inverted := settings
+	inverted.MinMilliCPU, inverted.MaxMilliCPU = 4000, 1000
+	require.ErrorContains(t, validateAutoscaling(inverted, types.Int64Value(2000), types.Int64Value(8)), "must not be larger")
+
+	thresholds := settings
+	thresholds.ScaleDownThreshold = 80
+	require.ErrorContains(t, validateAutoscaling(thresholds, types.Int64Value(2000), types.Int64Value(8)), "scale_down_threshold")
+}
+
+func TestServiceToResource_ConnectionPooler(t *testing.T) {
+	s := newTestService()
+	s.ServiceSpec.PoolerSettings = &tsClient.PoolerSettings{
+		PoolMode:             "TRANSACTION",
+		DefaultPoolSize:      20,
+		MaxClientConnections: 1000,
+		Databases: []tsClient.PoolerDatabaseSettings{
+			{Name: "analytics", PoolMode: "SESSION"},
+			{Name: "tsdb", PoolSize: 40},
+		},
+	}
+	model := serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.True(t, model.ConnectionPooler.IsNull(), "settings of a disabled pooler are not reflected")
+
+	s.ServiceSpec.PoolerEnabled = true
+	model = serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.Equal(t, "transaction", model.ConnectionPooler.Attributes()["pool_mode"].(types.String).ValueString())
+	settings, diags := poolerSettingsFromModel(context.Background(), model.ConnectionPooler)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+	require.Equal(t, *s.ServiceSpec.PoolerSettings, settings)
+}
+
+func TestValidatePoolerSettings(t *testing.T) {
+	settings := tsClient.PoolerSettings{DefaultPoolSize: 50, MaxClientConnections: 2000, Databases: []tsClient.PoolerDatabaseSettings{{Name: "tsdb", PoolSize: 100}}}
+	require.NoError(t, validatePoolerSettings(settings, 500, 2))
+	require.ErrorContains(t, validatePoolerSettings(tsClient.PoolerSettings{DefaultPoolSize: 150}, 500, 2), "default_pool_size (150)")
+	require.NoError(t, validatePoolerSettings(tsClient.PoolerSettings{DefaultPoolSize: 150}, 1000, 4))
+	require.ErrorContains(t, validatePoolerSettings(tsClient.PoolerSettings{Databases: []tsClient.PoolerDatabaseSettings{{Name: "tsdb", PoolSize: 101}}}, 500, 2), `database "tsdb"`)
+	require.ErrorContains(t, validatePoolerSettings(tsClient.PoolerSettings{MaxClientConnections: 2501}, 500, 2), "max_client_connections (2501)")
+}
+
+func TestValidateReplicaSourceUpdate(t *testing.T) {
+	model := func(source string, promote bool) serviceResourceModel {
+		m := serviceResourceModel{ReadReplicaSource: types.StringNull(), Promote: types.BoolNull()}
+		if source != "" {
+			m.ReadReplicaSource = types.StringValue(source)
+		}
+		if promote {
+			m.Promote = types.BoolValue(true)
+		}
+		return m
+	}
+	cases := map[string]struct {
+		state, plan serviceResourceModel
+		wantErr     string
+		promotion   bool
+	}{
+		"unchanged replica":               {state: model("src", false), plan: model("src", false)},
+		"unchanged primary":               {state: model("", false), plan: model("", false)},
+		"remove source":                   {state: model("src", false), plan: model("", false), wantErr: errUpdateReplicaSource},
+		"change source":                   {state: model("src", false), plan: model("other", false), wantErr: errUpdateReplicaSource},
+		"add source":                      {state: model("", false), plan: model("src", false), wantErr: errUpdateReplicaSource},
+		"promote keeping source":          {state: model("src", false), plan: model("src", true), promotion: true},
+		"promote removing source":         {state: model("src", false), plan: model("", true), promotion: true},
+		"promote and change source":       {state: model("src", false), plan: model("other", true), wantErr: errUpdateReplicaSource, promotion: true},
+		"promoted removes source":         {state: model("src", true), plan: model("", false)},
+		"promoted unsets promote":         {state: model("src", true), plan: model("src", false), wantErr: errDemoteReplica},
+		"promoted without source is kept": {state: model("", true), plan: model("", false)},
+		"promote on a primary":            {state: model("", false), plan: model("", true)},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateReplicaSourceUpdate(tc.state, tc.plan)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+			require.Equal(t, tc.promotion, isPromotion(tc.state, tc.plan))
+		})
+	}
+}
+
+func TestSaveProgress(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&serviceResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	prior := serviceToResource(&diag.Diagnostics{}, newTestService(), serviceResourceModel{
+		Timeouts:        timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})},
+		Tags:            types.MapNull(types.StringType),
+		RestartOnChange: types.MapNull(types.StringType),
+	}, nil)
+	diags := resp.State.Set(ctx, prior)
+	require.False(t, diags.HasError(), "diags: %v", diags)
+
+	saveProgress(ctx, resp, map[string]attr.Value{
+		"name":        types.StringValue("renamed"),
+		"ha_replicas": types.Int64Unknown(),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "diags: %v", resp.Diagnostics)
+
+	var saved serviceResourceModel
+	require.False(t, resp.State.Get(ctx, &saved).HasError())
+	require.Equal(t, "renamed", saved.Name.ValueString())
+	require.Equal(t, prior.HAReplicas, saved.HAReplicas, "unknown values must keep the prior state")
+}
+
+func TestServiceToResource_Lifecycle(t *testing.T) {
+	s := newTestService()
+	s.Created = "2024-06-01T10:00:00Z"
+	model := serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.Equal(t, "READY", model.Status.ValueString())
+	require.Equal(t, "2024-06-01T10:00:00Z", model.Created.ValueString())
+	require.True(t, model.ReplicaStatus.IsNull())
+	require.True(t, model.ForkedFrom.IsNull())
+
+	s.ReplicaStatus = "ASYNC"
+	s.ForkSpec = &tsClient.ForkSpec{ProjectID: "proj", ServiceID: "source"}
+	model = serviceToResource(&diag.Diagnostics{}, s, serviceResourceModel{}, nil)
+	require.Equal(t, "ASYNC", model.ReplicaStatus.ValueString())
+	require.Equal(t, "source", model.ForkedFrom.ValueString())
+	require.True(t, model.ReadReplicaSource.IsNull(), "a fork is not a read replica")
+}
+
+func TestResolveServiceImportID(t *testing.T) {
+	services := []*tsClient.Service{
+		{ID: "svc-1", Name: "orders"},
+		{ID: "svc-3", Name: "metrics"},
+		{ID: "svc-2", Name: "metrics"},
+	}
+	listServices := func(context.Context) ([]*tsClient.Service, error) { return services, nil }
+	cases := map[string]struct {
+		importID string
+		want     string
+		wantErr  string
+	}{
+		"id":                {importID: "svc-1", want: "svc-1"},
+		"project and id":    {importID: "proj/svc-1", want: "svc-1"},
+		"other project":     {importID: "other/svc-1", wantErr: "belongs to project other"},
+		"missing id":        {importID: "proj/", wantErr: "expected an import identifier"},
+		"name":              {importID: "name:orders", want: "svc-1"},
+		"unknown name":      {importID: "name:billing", wantErr: `no service named "billing"`},
+		"ambiguous name":    {importID: "name:metrics", wantErr: `2 services are named "metrics" (svc-2, svc-3)`},
+		"empty name":        {importID: "name:", wantErr: "expected an import identifier"},
+		"empty identifier":  {importID: "", wantErr: "expected an import identifier"},
+		"name with a slash": {importID: "name:a/b", wantErr: `no service named "a/b"`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := resolveServiceImportID(context.Background(), tc.importID, "proj", listServices)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, id)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &serviceRestartAction{}
+	_ action.ActionWithConfigure = &serviceRestartAction{}
+)
+
+// NewServiceRestartAction is a helper function to simplify the provider implementation.
+func NewServiceRestartAction() action.Action {
+	return &serviceRestartAction{}
+}
+
+// serviceRestartAction restarts a service and waits for it to be ready again.
+type serviceRestartAction struct {
+	client *tsClient.Client
+}
+
+type serviceRestartActionModel struct {
+	ServiceID types.String `tfsdk:"service_id"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+func (a *serviceRestartAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_restart"
+}
+
+func (a *serviceRestartAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Restarts a service and waits until it is ready again.
+
+Use it to complete changes that only take effect after a restart, such as a log exporter attachment or restart-requiring
+` + "`timescale_service_parameters`" + `. Requires Terraform 1.14 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service to restart.",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the service to be ready after the restart, as a Go duration such as `30m`. Defaults to `45m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (a *serviceRestartAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Trace(ctx, "serviceRestartAction.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = data.client
+}
+
+func (a *serviceRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "serviceRestartAction.Invoke")
+	var config serviceRestartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := actionTimeout(config.Timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Duration", err.Error())
+		return
+	}
+	serviceID := config.ServiceID.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting service %s", serviceID)})
+	if err := a.client.RestartService(ctx, serviceID); err != nil {
+		resp.Diagnostics.AddError("Unable to Restart Service", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for service %s to be ready", serviceID)})
+	if _, err := waitForServiceReadiness(ctx, a.client, serviceID, timeout); err != nil {
+		resp.Diagnostics.AddError(ErrRestartTimeout, fmt.Sprintf("error occurred while waiting for service restart, got error: %s", err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Service %s restarted", serviceID)})
+}
+
+// actionTimeout returns the timeout configured on an action, or the default
+// service timeout when none is.
+func actionTimeout(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultServiceTimeout, nil
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%q is not a positive duration such as 30m or 1h", value.ValueString())
+	}
+	return d, nil
+}
+
+// durationValidator validates that a string is a positive Go duration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30m or 1h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := actionTimeout(req.ConfigValue); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", err.Error())
+	}
+}
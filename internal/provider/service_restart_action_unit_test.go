@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationValidator(t *testing.T) {
+	cases := map[string]bool{
+		"30m":   true,
+		"1h30m": true,
+		"0s":    false,
+		"-5m":   false,
+		"45":    false,
+		"soon":  false,
+	}
+	for value, valid := range cases {
+		t.Run(value, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timeout"), ConfigValue: types.StringValue(value)}
+			resp := &validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), req, resp)
+			require.Equal(t, !valid, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestActionTimeout(t *testing.T) {
+	timeout, err := actionTimeout(types.StringNull())
+	require.NoError(t, err)
+	require.Equal(t, defaultServiceTimeout, timeout)
+
+	timeout, err = actionTimeout(types.StringValue("45m"))
+	require.NoError(t, err)
+	require.Equal(t, 45*time.Minute, timeout)
+
+	_, err = actionTimeout(types.StringValue("soon"))
+	require.EqualError(t, err, `"soon" is not a positive duration such as 30m or 1h`)
+}
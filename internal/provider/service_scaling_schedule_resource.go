@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+	multiplyvalidator "github.com/timescale/terraform-provider-timescale/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceScalingScheduleResource{}
+	_ resource.ResourceWithConfigure   = &serviceScalingScheduleResource{}
+	_ resource.ResourceWithImportState = &serviceScalingScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &serviceScalingScheduleResource{}
+)
+
+const errScalingScheduleWithAutoscaling = "service %s has autoscaling enabled, remove the autoscaling block of the service or this scaling schedule"
+
+// NewServiceScalingScheduleResource is a helper function to simplify the provider implementation.
+func NewServiceScalingScheduleResource() resource.Resource {
+	return &serviceScalingScheduleResource{}
+}
+
+// serviceScalingScheduleResource resizes a service according to cron windows.
+type serviceScalingScheduleResource struct {
+	cronScheduleResource
+}
+
+type serviceScalingScheduleResourceModel struct {
+	ID              types.String         `tfsdk:"id"`
+	ServiceID       types.String         `tfsdk:"service_id"`
+	Timezone        types.String         `tfsdk:"timezone"`
+	MilliCPU        types.Int64          `tfsdk:"milli_cpu"`
+	MemoryGB        types.Int64          `tfsdk:"memory_gb"`
+	Windows         []scalingWindowModel `tfsdk:"windows"`
+	DesiredMilliCPU types.Int64          `tfsdk:"desired_milli_cpu"`
+	DesiredMemoryGB types.Int64          `tfsdk:"desired_memory_gb"`
+	Timeouts        timeouts.Value       `tfsdk:"timeouts"`
+}
+
+type scalingWindowModel struct {
+	StartAt  types.String `tfsdk:"start_at"`
+	EndAt    types.String `tfsdk:"end_at"`
+	MilliCPU types.Int64  `tfsdk:"milli_cpu"`
+	MemoryGB types.Int64  `tfsdk:"memory_gb"`
+}
+
+// Metadata returns the resource type name.
+func (r *serviceScalingScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_scaling_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceScalingScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := cronScheduleAttributes(ctx, "The ID of the service to resize.")
+	attributes["milli_cpu"] = schema.Int64Attribute{
+		MarkdownDescription: "Milli CPU of the service outside of the windows.",
+		Required:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(milliCPUSizes...),
+			multiplyvalidator.EqualToMultipleOf(250, path.MatchRoot("memory_gb")),
+		},
+	}
+	attributes["memory_gb"] = schema.Int64Attribute{
+		MarkdownDescription: "Memory GB of the service outside of the windows.",
+		Required:            true,
+		Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+	}
+	attributes["windows"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Windows during which the service runs with another size. When windows overlap, the first active one in the list applies.",
+		Required:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_at": schema.StringAttribute{
+					MarkdownDescription: "Cron expression (minute hour day-of-month month day-of-week) of the times the window starts, e.g. `0 1 * * *`.",
+					Required:            true,
+					Validators: []validator.String{
+						cronValidator{},
+					},
+				},
+				"end_at": schema.StringAttribute{
+					MarkdownDescription: "Cron expression of the times the window ends, e.g. `0 5 * * *`.",
+					Required:            true,
+					Validators: []validator.String{
+						cronValidator{},
+					},
+				},
+				"milli_cpu": schema.Int64Attribute{
+					MarkdownDescription: "Milli CPU of the service during the window.",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.OneOf(milliCPUSizes...),
+						multiplyvalidator.EqualToMultipleOf(250, path.MatchRelative().AtParent().AtName("memory_gb")),
+					},
+				},
+				"memory_gb": schema.Int64Attribute{
+					MarkdownDescription: "Memory GB of the service during the window.",
+					Required:            true,
+					Validators:          []validator.Int64{int64validator.OneOf(memorySizes...)},
+				},
+			},
+		},
+	}
+	attributes["desired_milli_cpu"] = schema.Int64Attribute{
+		MarkdownDescription: "Milli CPU the schedule wants the service to have. Planned from the schedule at plan time and refreshed from the actual service size, so a difference shows up as a change that the apply reconciles.",
+		Computed:            true,
+	}
+	attributes["desired_memory_gb"] = schema.Int64Attribute{
+		MarkdownDescription: "Memory GB the schedule wants the service to have, see `desired_milli_cpu`.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Resizes a service during recurring windows, such as a nightly batch window, and back to a baseline size afterwards.
+
+Each window is a pair of cron expressions: the service has the size of the window from a ` + "`start_at`" + ` time until the following ` + "`end_at`" + ` time.
+` + cronScheduleEvaluation + `
+Resizing restarts the service, or fails over to an HA replica when it has one.
+
+Add ` + "`lifecycle { ignore_changes = [milli_cpu, memory_gb] }`" + ` to the ` + "`timescale_service`" + ` so that it does not undo the schedule.
+The service must not use ` + "`autoscaling`" + `. Destroying this resource leaves the service at its current size.`,
+		Attributes: attributes,
+	}
+}
+
+// ModifyPlan evaluates the schedule and plans the desired size.
+func (r *serviceScalingScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.now == nil {
+		return
+	}
+	var plan serviceScalingScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !scalingScheduleKnown(plan) {
+		return
+	}
+
+	milliCPU, memoryGB, err := scheduleTargetSize(plan, r.now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("windows"), ErrInvalidAttribute, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("desired_milli_cpu"), types.Int64Value(milliCPU))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("desired_memory_gb"), types.Int64Value(memoryGB))...)
+
+	if !req.State.Raw.IsNull() {
+		var state serviceScalingScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !state.DesiredMilliCPU.IsNull() && (state.DesiredMilliCPU.ValueInt64() != milliCPU || state.DesiredMemoryGB.ValueInt64() != memoryGB) {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Service %s will be resized to %d milli CPU and %d GB memory", plan.ServiceID.ValueString(), milliCPU, memoryGB),
+				"The service does not match its scaling schedule and will be resized on apply. Open connections are dropped during the resize.",
+			)
+		}
+	}
+}
+
+// scalingScheduleKnown reports whether the schedule can be evaluated.
+func scalingScheduleKnown(m serviceScalingScheduleResourceModel) bool {
+	if m.Timezone.IsUnknown() || m.MilliCPU.IsUnknown() || m.MemoryGB.IsUnknown() {
+		return false
+	}
+	for _, w := range m.Windows {
+		if w.StartAt.IsUnknown() || w.EndAt.IsUnknown() || w.MilliCPU.IsUnknown() || w.MemoryGB.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// scheduleTargetSize returns the size of the first window active at now, or
+// the baseline size when none is.
+func scheduleTargetSize(m serviceScalingScheduleResourceModel, now time.Time) (milliCPU, memoryGB int64, err error) {
+	cronWindows := make([]cronWindow, len(m.Windows))
+	for i, w := range m.Windows {
+		cronWindows[i] = cronWindow{start: w.StartAt.ValueString(), end: w.EndAt.ValueString()}
+	}
+	active, err := activeCronWindow(cronWindows, m.Timezone.ValueString(), now)
+	if err != nil {
+		return 0, 0, err
+	}
+	if active >= 0 {
+		return m.Windows[active].MilliCPU.ValueInt64(), m.Windows[active].MemoryGB.ValueInt64(), nil
+	}
+	return m.MilliCPU.ValueInt64(), m.MemoryGB.ValueInt64(), nil
+}
+
+// Create applies the schedule for the first time.
+func (r *serviceScalingScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "serviceScalingScheduleResource.Create")
+	var plan serviceScalingScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, &plan, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Apply Service Scaling Schedule", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the desired size with the actual size of the service.
+func (r *serviceScalingScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "serviceScalingScheduleResource.Read")
+	var state serviceScalingScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetService(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Service not found, removing scaling schedule from state.", map[string]any{"service_id": state.ServiceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read Service", err.Error())
+		return
+	}
+	state.ID = state.ServiceID
+	if len(service.Resources) > 0 {
+		state.DesiredMilliCPU = types.Int64Value(service.Resources[0].Spec.MilliCPU)
+		state.DesiredMemoryGB = types.Int64Value(service.Resources[0].Spec.MemoryGB)
+	}
+	if state.Timezone.IsNull() {
+		state.Timezone = types.StringValue("UTC")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the schedule.
+func (r *serviceScalingScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "serviceScalingScheduleResource.Update")
+	var plan serviceScalingScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Update(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, &plan, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Apply Service Scaling Schedule", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the schedule, the service keeps its current size.
+func (r *serviceScalingScheduleResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Trace(ctx, "serviceScalingScheduleResource.Delete")
+}
+
+// reconcile resizes the service to the planned desired size.
+func (r *serviceScalingScheduleResource) reconcile(ctx context.Context, plan *serviceScalingScheduleResourceModel, timeout time.Duration) error {
+	serviceID := plan.ServiceID.ValueString()
+	plan.ID = plan.ServiceID
+
+	return r.reconcileService(ctx, serviceID, timeout, func(service *tsClient.Service, now time.Time) (bool, error) {
+		if service.Autoscaling != nil && service.Autoscaling.Enabled {
+			return false, fmt.Errorf(errScalingScheduleWithAutoscaling, serviceID)
+		}
+		if plan.DesiredMilliCPU.IsUnknown() || plan.DesiredMemoryGB.IsUnknown() {
+			// The schedule could not be evaluated at plan time.
+			milliCPU, memoryGB, err := scheduleTargetSize(*plan, now)
+			if err != nil {
+				return false, err
+			}
+			plan.DesiredMilliCPU = types.Int64Value(milliCPU)
+			plan.DesiredMemoryGB = types.Int64Value(memoryGB)
+		}
+		if len(service.Resources) > 0 &&
+			service.Resources[0].Spec.MilliCPU == plan.DesiredMilliCPU.ValueInt64() &&
+			service.Resources[0].Spec.MemoryGB == plan.DesiredMemoryGB.ValueInt64() {
+			return false, nil
+		}
+
+		tflog.Info(ctx, "resizing service to match its scaling schedule", map[string]any{
+			"service_id": serviceID,
+			"milli_cpu":  plan.DesiredMilliCPU.ValueInt64(),
+			"memory_gb":  plan.DesiredMemoryGB.ValueInt64(),
+		})
+		err := r.client.ResizeInstance(ctx, serviceID, tsClient.ResourceConfig{
+			MilliCPU: strconv.FormatInt(plan.DesiredMilliCPU.ValueInt64(), 10),
+			MemoryGB: strconv.FormatInt(plan.DesiredMemoryGB.ValueInt64(), 10),
+		})
+		return err == nil, err
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestScheduleTargetSize(t *testing.T) {
+	schedule := serviceScalingScheduleResourceModel{
+		Timezone: types.StringValue("UTC"),
+		MilliCPU: types.Int64Value(500),
+		MemoryGB: types.Int64Value(2),
+		Windows: []scalingWindowModel{
+			// Month-end reporting, takes precedence over the nightly batch.
+			{StartAt: types.StringValue("0 0 28-31 * *"), EndAt: types.StringValue("0 6 28-31 * *"), MilliCPU: types.Int64Value(8000), MemoryGB: types.Int64Value(32)},
+			{StartAt: types.StringValue("0 1 * * *"), EndAt: types.StringValue("0 5 * * *"), MilliCPU: types.Int64Value(2000), MemoryGB: types.Int64Value(8)},
+		},
+	}
+	cases := map[string]struct {
+		now                time.Time
+		milliCPU, memoryGB int64
+	}{
+		"daytime":           {time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC), 500, 2},
+		"nightly batch":     {time.Date(2024, 6, 12, 3, 0, 0, 0, time.UTC), 2000, 8},
+		"at window end":     {time.Date(2024, 6, 12, 5, 0, 0, 0, time.UTC), 500, 2},
+		"overlapping first": {time.Date(2024, 6, 28, 3, 0, 0, 0, time.UTC), 8000, 32},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			milliCPU, memoryGB, err := scheduleTargetSize(schedule, tc.now)
+			require.NoError(t, err)
+			require.Equal(t, tc.milliCPU, milliCPU)
+			require.Equal(t, tc.memoryGB, memoryGB)
+		})
+	}
+
+	schedule.Timezone = types.StringValue("Mars/Olympus")
+	_, _, err := scheduleTargetSize(schedule, time.Now())
+	require.Error(t, err)
+}
+
+func TestServiceScalingScheduleResource_ReconcileUsesClock(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"data":{"getService":{"id":"svc-1","status":"READY","resources":[{"id":"r","spec":{"milliCPU":2000,"memoryGB":8}}]}}}`)
+	}))
+	defer srv.Close()
+	t.Setenv("TIMESCALE_DEV_URL", srv.URL)
+
+	// Inside the nightly batch window.
+	night := time.Date(2024, 6, 12, 2, 0, 0, 0, time.UTC)
+	r := &serviceScalingScheduleResource{cronScheduleResource{
+		client: tsClient.NewClient("token", "proj", "test", "1.0.0"),
+		now:    func() time.Time { return night },
+	}}
+	plan := serviceScalingScheduleResourceModel{
+		ServiceID: types.StringValue("svc-1"),
+		Timezone:  types.StringValue("UTC"),
+		MilliCPU:  types.Int64Value(500),
+		MemoryGB:  types.Int64Value(2),
+		Windows: []scalingWindowModel{
+			{StartAt: types.StringValue("0 1 * * *"), EndAt: types.StringValue("0 5 * * *"), MilliCPU: types.Int64Value(2000), MemoryGB: types.Int64Value(8)},
+		},
+		DesiredMilliCPU: types.Int64Unknown(),
+		DesiredMemoryGB: types.Int64Unknown(),
+	}
+	require.NoError(t, r.reconcile(t.Context(), &plan, time.Minute))
+	require.Equal(t, int64(2000), plan.DesiredMilliCPU.ValueInt64())
+	require.Equal(t, int64(8), plan.DesiredMemoryGB.ValueInt64())
+	require.Equal(t, 1, calls, "a service that already has the window size is not resized")
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceScheduleResource{}
+	_ resource.ResourceWithConfigure   = &serviceScheduleResource{}
+	_ resource.ResourceWithImportState = &serviceScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &serviceScheduleResource{}
+)
+
+// NewServiceScheduleResource is a helper function to simplify the provider implementation.
+func NewServiceScheduleResource() resource.Resource {
+	return &serviceScheduleResource{}
+}
+
+// serviceScheduleResource pauses and resumes a service according to cron windows.
+type serviceScheduleResource struct {
+	cronScheduleResource
+}
+
+type serviceScheduleResourceModel struct {
+	ID            types.String       `tfsdk:"id"`
+	ServiceID     types.String       `tfsdk:"service_id"`
+	Timezone      types.String       `tfsdk:"timezone"`
+	PauseWindows  []pauseWindowModel `tfsdk:"pause_windows"`
+	DesiredPaused types.Bool         `tfsdk:"desired_paused"`
+	Timeouts      timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type pauseWindowModel struct {
+	PauseAt  types.String `tfsdk:"pause_at"`
+	ResumeAt types.String `tfsdk:"resume_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *serviceScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := cronScheduleAttributes(ctx, "The ID of the service to pause and resume.")
+	attributes["pause_windows"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Windows during which the service is paused. The service is paused while any window is active.",
+		Required:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"pause_at": schema.StringAttribute{
+					MarkdownDescription: "Cron expression (minute hour day-of-month month day-of-week) of the times the window starts, e.g. `0 20 * * MON-FRI`.",
+					Required:            true,
+					Validators: []validator.String{
+						cronValidator{},
+					},
+				},
+				"resume_at": schema.StringAttribute{
+					MarkdownDescription: "Cron expression of the times the window ends, e.g. `0 8 * * MON-FRI`.",
+					Required:            true,
+					Validators: []validator.String{
+						cronValidator{},
+					},
+				},
+			},
+		},
+	}
+	attributes["desired_paused"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the schedule wants the service paused. Planned from the schedule at plan time and refreshed from the actual service state, so a difference shows up as a change that the apply reconciles.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Pauses a service during recurring windows, such as nights and weekends for development services.
+
+Each window is a pair of cron expressions: the service is paused from a ` + "`pause_at`" + ` time until the following ` + "`resume_at`" + ` time.
+` + cronScheduleEvaluation + `
+
+Leave ` + "`paused`" + ` unset on the ` + "`timescale_service`" + ` so that it does not undo the schedule.
+Destroying this resource leaves the service in its current state.`,
+		Attributes: attributes,
+	}
+}
+
+// ModifyPlan evaluates the schedule and plans the desired paused state.
+func (r *serviceScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.now == nil {
+		return
+	}
+	var plan serviceScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Timezone.IsUnknown() {
+		return
+	}
+	for _, w := range plan.PauseWindows {
+		if w.PauseAt.IsUnknown() || w.ResumeAt.IsUnknown() {
+			return
+		}
+	}
+
+	paused, err := scheduleWantsPaused(plan.PauseWindows, plan.Timezone.ValueString(), r.now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pause_windows"), ErrInvalidAttribute, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("desired_paused"), types.BoolValue(paused))...)
+
+	if !req.State.Raw.IsNull() {
+		var state serviceScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !state.DesiredPaused.IsNull() && state.DesiredPaused.ValueBool() != paused {
+			action := "resumed"
+			if paused {
+				action = "paused"
+			}
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Service %s will be %s", plan.ServiceID.ValueString(), action),
+				"The service does not match its pause schedule and will be reconciled on apply.",
+			)
+		}
+	}
+}
+
+// scheduleWantsPaused reports whether any window is active at now: the latest
+// pause time of the window is more recent than its latest resume time.
+func scheduleWantsPaused(windows []pauseWindowModel, timezone string, now time.Time) (bool, error) {
+	cronWindows := make([]cronWindow, len(windows))
+	for i, w := range windows {
+		cronWindows[i] = cronWindow{start: w.PauseAt.ValueString(), end: w.ResumeAt.ValueString()}
+	}
+	active, err := activeCronWindow(cronWindows, timezone, now)
+	return active >= 0, err
+}
+
+// Create applies the schedule for the first time.
+func (r *serviceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "serviceScheduleResource.Create")
+	var plan serviceScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, &plan, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Apply Service Schedule", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes desired_paused with the actual state of the service.
+func (r *serviceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "serviceScheduleResource.Read")
+	var state serviceScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetService(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if errors.Is(err, tsClient.ErrServiceNotFound) {
+			tflog.Warn(ctx, "Service not found, removing schedule from state.", map[string]any{"service_id": state.ServiceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to Read Service", err.Error())
+		return
+	}
+	state.ID = state.ServiceID
+	state.DesiredPaused = types.BoolValue(isServicePaused(service))
+	if state.Timezone.IsNull() {
+		state.Timezone = types.StringValue("UTC")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the schedule.
+func (r *serviceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "serviceScheduleResource.Update")
+	var plan serviceScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Update(ctx, defaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, &plan, timeout); err != nil {
+		resp.Diagnostics.AddError("Unable to Apply Service Schedule", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the schedule, the service is left as it is.
+func (r *serviceScheduleResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Trace(ctx, "serviceScheduleResource.Delete")
+}
+
+// reconcile pauses or resumes the service to match the planned desired_paused.
+func (r *serviceScheduleResource) reconcile(ctx context.Context, plan *serviceScheduleResourceModel, timeout time.Duration) error {
+	serviceID := plan.ServiceID.ValueString()
+	plan.ID = plan.ServiceID
+
+	return r.reconcileService(ctx, serviceID, timeout, func(service *tsClient.Service, now time.Time) (bool, error) {
+		if plan.DesiredPaused.IsUnknown() || plan.DesiredPaused.IsNull() {
+			// The schedule could not be evaluated at plan time.
+			paused, err := scheduleWantsPaused(plan.PauseWindows, plan.Timezone.ValueString(), now)
+			if err != nil {
+				return false, err
+			}
+			plan.DesiredPaused = types.BoolValue(paused)
+		}
+		if isServicePaused(service) == plan.DesiredPaused.ValueBool() {
+			return false, nil
+		}
+
+		status := "ACTIVE"
+		if plan.DesiredPaused.ValueBool() {
+			status = "INACTIVE"
+		}
+		tflog.Info(ctx, "toggling service to match its schedule", map[string]any{"service_id": serviceID, "status": status})
+		_, err := r.client.ToggleService(ctx, serviceID, status)
+		return err == nil, err
+	})
+}
+
+func isServicePaused(s *tsClient.Service) bool {
+	return s.Status == "PAUSED" || s.Status == "PAUSING"
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+func TestParseCron(t *testing.T) {
+	valid := []string{
+		"0 20 * * MON-FRI",
+		"*/15 * * * *",
+		"0 0 1,15 * *",
+		"30 8 * jan-mar 1-5",
+		"0 0 * * 7",
+	}
+	for _, expr := range valid {
+		_, err := parseCron(expr)
+		require.NoError(t, err, expr)
+	}
+	invalid := []string{
+		"",
+		"0 20 * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 0 0 * *",
+		"0 0 * 13 *",
+		"0 0 * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"0 0 * * someday",
+	}
+	for _, expr := range invalid {
+		_, err := parseCron(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestCronPrevNext(t *testing.T) {
+	c, err := parseCron("0 20 * * MON-FRI")
+	require.NoError(t, err)
+
+	// Saturday 2024-06-15 10:00 UTC.
+	sat := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	prev, ok := c.prev(sat)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 6, 14, 20, 0, 0, 0, time.UTC), prev)
+	next, ok := c.next(sat)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 6, 17, 20, 0, 0, 0, time.UTC), next)
+
+	// A matching time is its own prev but not its own next.
+	prev, ok = c.prev(next)
+	require.True(t, ok)
+	require.Equal(t, next, prev)
+	next2, ok := c.next(next)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 6, 18, 20, 0, 0, 0, time.UTC), next2)
+
+	// Day of month and day of week are ORed when both are restricted.
+	c, err = parseCron("0 0 13 * FRI")
+	require.NoError(t, err)
+	next, ok = c.next(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC), next)
+
+	// February 30th never happens.
+	c, err = parseCron("0 0 30 2 *")
+	require.NoError(t, err)
+	_, ok = c.prev(sat)
+	require.False(t, ok)
+}
+
+func TestScheduleWantsPaused(t *testing.T) {
+	windows := []pauseWindowModel{
+		{PauseAt: types.StringValue("0 20 * * MON-FRI"), ResumeAt: types.StringValue("0 8 * * MON-FRI")},
+	}
+	cases := map[string]struct {
+		now    time.Time
+		paused bool
+	}{
+		"weekday working hours": {time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC), false},
+		"weekday night":         {time.Date(2024, 6, 12, 23, 0, 0, 0, time.UTC), true},
+		"early morning":         {time.Date(2024, 6, 13, 7, 59, 0, 0, time.UTC), true},
+		"at resume time":        {time.Date(2024, 6, 13, 8, 0, 0, 0, time.UTC), false},
+		"weekend":               {time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC), true},
+		"monday morning":        {time.Date(2024, 6, 17, 9, 0, 0, 0, time.UTC), false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			paused, err := scheduleWantsPaused(windows, "UTC", tc.now)
+			require.NoError(t, err)
+			require.Equal(t, tc.paused, paused)
+		})
+	}
+
+	// Windows are evaluated in the schedule's time zone: 21:00 in Madrid is 19:00 UTC in summer.
+	paused, err := scheduleWantsPaused(windows, "Europe/Madrid", time.Date(2024, 6, 12, 19, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	_, err = scheduleWantsPaused(windows, "Mars/Olympus", time.Now())
+	require.Error(t, err)
+}
+
+func TestServiceScheduleResource_ReconcileUsesClock(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"data":{"getService":{"id":"svc-1","status":"PAUSED"}}}`)
+	}))
+	defer srv.Close()
+	t.Setenv("TIMESCALE_DEV_URL", srv.URL)
+
+	// Saturday, inside the weekend window of the schedule.
+	saturday := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	r := &serviceScheduleResource{cronScheduleResource{
+		client: tsClient.NewClient("token", "proj", "test", "1.0.0"),
+		now:    func() time.Time { return saturday },
+	}}
+	plan := serviceScheduleResourceModel{
+		ServiceID: types.StringValue("svc-1"),
+		Timezone:  types.StringValue("UTC"),
+		PauseWindows: []pauseWindowModel{
+			{PauseAt: types.StringValue("0 20 * * MON-FRI"), ResumeAt: types.StringValue("0 8 * * MON-FRI")},
+		},
+		DesiredPaused: types.BoolUnknown(),
+	}
+	require.NoError(t, r.reconcile(t.Context(), &plan, time.Minute))
+	require.True(t, plan.DesiredPaused.ValueBool())
+	require.Equal(t, 1, calls, "an already paused service is not toggled")
+}
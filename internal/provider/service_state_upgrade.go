@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// serviceSchemaVersion is the version of the timescale_service schema.
+const serviceSchemaVersion = 1
+
+// UpgradeState migrates state written by older versions of this provider.
+//
+// v0 → v1: services created with the deprecated enable_ha_replica attribute
+// could be stored without ha_replicas and sync_replicas. They are derived
+// from enable_ha_replica, so the replica counts no longer depend on it.
+// enable_ha_replica itself is kept so that configurations still setting it
+// plan without changes.
+func (r *serviceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: r.upgradeServiceV0ToV1,
+		},
+	}
+}
+
+func (r *serviceResource) upgradeServiceV0ToV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		return
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(req.RawState.JSON, &raw); err != nil {
+		resp.Diagnostics.AddError("Unable to parse prior state JSON", err.Error())
+		return
+	}
+	upgradeHAReplicas(raw)
+
+	// Attributes removed from the schema since the state was written are dropped.
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	for name := range raw {
+		if _, ok := schemaResp.Schema.Attributes[name]; !ok {
+			delete(raw, name)
+		}
+	}
+
+	upgraded, err := json.Marshal(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode upgraded state", err.Error())
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// upgradeHAReplicas fills in ha_replicas and sync_replicas of a raw v0 state
+// from enable_ha_replica.
+func upgradeHAReplicas(raw map[string]any) {
+	isReadReplica := raw["read_replica_source"] != nil && raw["read_replica_source"] != ""
+	if raw["ha_replicas"] == nil && !isReadReplica {
+		haReplicas := 0
+		if enabled, _ := raw["enable_ha_replica"].(bool); enabled {
+			haReplicas = 1
+		}
+		raw["ha_replicas"] = haReplicas
+	}
+	if raw["sync_replicas"] == nil {
+		raw["sync_replicas"] = 0
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceUpgradeStateV0ToV1(t *testing.T) {
+	ctx := context.Background()
+	r := &serviceResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.Equal(t, int64(serviceSchemaVersion), schemaResp.Schema.GetVersion())
+
+	v0, ok := r.UpgradeState(ctx)[0]
+	require.True(t, ok, "v0→v1 upgrader must be registered")
+
+	cases := []struct {
+		name             string
+		json             string
+		wantHAReplicas   types.Int64
+		wantSyncReplicas int64
+		wantEnableHA     types.Bool
+	}{
+		{
+			name:             "enable_ha_replica true",
+			json:             `{"id": "svc-1", "name": "a", "enable_ha_replica": true}`,
+			wantHAReplicas:   types.Int64Value(1),
+			wantSyncReplicas: 0,
+			wantEnableHA:     types.BoolValue(true),
+		},
+		{
+			name:           "enable_ha_replica false",
+			json:           `{"id": "svc-1", "name": "a", "enable_ha_replica": false, "ha_replicas": null}`,
+			wantHAReplicas: types.Int64Value(0),
+			wantEnableHA:   types.BoolValue(false),
+		},
+		{
+			name:             "replica counts already set",
+			json:             `{"id": "svc-1", "name": "a", "ha_replicas": 2, "sync_replicas": 1}`,
+			wantHAReplicas:   types.Int64Value(2),
+			wantSyncReplicas: 1,
+		},
+		{
+			name:           "read replica",
+			json:           `{"id": "svc-1", "name": "a", "read_replica_source": "svc-0", "read_replica_nodes": 2}`,
+			wantHAReplicas: types.Int64Null(),
+		},
+		{
+			name:           "removed attribute",
+			json:           `{"id": "svc-1", "name": "a", "ha_replicas": 0, "no_longer_exists": "x"}`,
+			wantHAReplicas: types.Int64Value(0),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			v0.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tc.json)}}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "diags: %v", resp.Diagnostics)
+			require.NotNil(t, resp.DynamicValue)
+
+			raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			require.NoError(t, err)
+			var got serviceResourceModel
+			diags := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}.Get(ctx, &got)
+			require.False(t, diags.HasError(), "diags: %v", diags)
+
+			require.Equal(t, "svc-1", got.ID.ValueString())
+			require.Equal(t, tc.wantHAReplicas, got.HAReplicas)
+			require.Equal(t, tc.wantSyncReplicas, got.SyncReplicas.ValueInt64())
+			require.Equal(t, tc.wantEnableHA.IsNull(), got.EnableHAReplica.IsNull())
+			require.Equal(t, tc.wantEnableHA.ValueBool(), got.EnableHAReplica.ValueBool())
+		})
+	}
+}
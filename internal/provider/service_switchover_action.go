@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &serviceSwitchoverAction{}
+	_ action.ActionWithConfigure = &serviceSwitchoverAction{}
+)
+
+const errSwitchoverWithoutHA = "service %s has no HA replicas to switch over to, set ha_replicas to 1 or 2 first"
+
+// NewServiceSwitchoverAction is a helper function to simplify the provider implementation.
+func NewServiceSwitchoverAction() action.Action {
+	return &serviceSwitchoverAction{}
+}
+
+// serviceSwitchoverAction promotes an HA replica of a service to primary and
+// waits for the service to be ready again.
+type serviceSwitchoverAction struct {
+	client *tsClient.Client
+}
+
+type serviceSwitchoverActionModel struct {
+	ServiceID types.String `tfsdk:"service_id"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+func (a *serviceSwitchoverAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_switchover"
+}
+
+func (a *serviceSwitchoverAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Performs a controlled switchover of a service with HA replicas: a replica becomes the primary and the former
+primary becomes a replica. Use it to test failover or to move the primary off its current hardware.
+
+The service must have ` + "`ha_replicas`" + ` set to 1 or 2. Open connections are dropped during the switchover.
+The ` + "`primary_node`" + ` and replica endpoints of the ` + "`timescale_service`" + ` are refreshed on the next plan.
+Requires Terraform 1.14 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service to switch over.",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the service to be ready after the switchover, as a Go duration such as `30m`. Defaults to `45m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (a *serviceSwitchoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Trace(ctx, "serviceSwitchoverAction.Configure")
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = data.client
+}
+
+func (a *serviceSwitchoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "serviceSwitchoverAction.Invoke")
+	var config serviceSwitchoverActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := actionTimeout(config.Timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Duration", err.Error())
+		return
+	}
+	serviceID := config.ServiceID.ValueString()
+
+	service, err := a.client.GetService(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Service", err.Error())
+		return
+	}
+	if len(service.Resources) == 0 || service.Resources[0].Spec.ReplicaCount == 0 {
+		resp.Diagnostics.AddError("Unable to Switch Over Service", fmt.Sprintf(errSwitchoverWithoutHA, serviceID))
+		return
+	}
+	previous := service.PrimaryNode
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Switching over service %s", serviceID)})
+	if err := a.client.SwitchoverService(ctx, serviceID); err != nil {
+		resp.Diagnostics.AddError("Unable to Switch Over Service", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for service %s to be ready", serviceID)})
+	service, err = waitForServiceReadiness(ctx, a.client, serviceID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for service switchover", fmt.Sprintf("error occurred while waiting for service switchover, got error: %s", err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: switchoverSummary(serviceID, previous, service.PrimaryNode)})
+}
+
+// switchoverSummary describes the primary change, when the API reports it.
+func switchoverSummary(serviceID, previous, current string) string {
+	switch {
+	case current == "":
+		return fmt.Sprintf("Service %s switched over", serviceID)
+	case previous == "" || previous == current:
+		return fmt.Sprintf("Service %s switched over, primary node is %s", serviceID, current)
+	default:
+		return fmt.Sprintf("Service %s switched over, primary node changed from %s to %s", serviceID, previous, current)
+	}
+}
@@ -0,0 +1,13 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwitchoverSummary(t *testing.T) {
+	require.Equal(t, "Service svc switched over", switchoverSummary("svc", "node-0", ""))
+	require.Equal(t, "Service svc switched over, primary node is node-1", switchoverSummary("svc", "", "node-1"))
+	require.Equal(t, "Service svc switched over, primary node changed from node-0 to node-1", switchoverSummary("svc", "node-0", "node-1"))
+}
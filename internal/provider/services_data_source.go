@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tsClient "github.com/timescale/terraform-provider-timescale/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &servicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &servicesDataSource{}
+
+func NewServicesDataSource() datasource.DataSource {
+	return &servicesDataSource{}
+}
+
+// servicesDataSource lists the services of the project.
+type servicesDataSource struct {
+	client *tsClient.Client
+}
+
+type servicesDataSourceModel struct {
+	Tags     types.Map                 `tfsdk:"tags"`
+	Services []servicesDataSourceEntry `tfsdk:"services"`
+	// following is a placeholder, required by terraform to run test suite
+	ID types.String `tfsdk:"id"`
+}
+
+type servicesDataSourceEntry struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	RegionCode     types.String `tfsdk:"region_code"`
+	Status         types.String `tfsdk:"status"`
+	EnvironmentTag types.String `tfsdk:"environment_tag"`
+	Tags           types.Map    `tfsdk:"tags"`
+}
+
+func (d *servicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *servicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the services of the project, optionally filtered by tags.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only return services that have all of these tags with the same values.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching services, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Service ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Service name.",
+							Computed:            true,
+						},
+						"region_code": schema.StringAttribute{
+							MarkdownDescription: "Region the service is located in.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Service status, e.g. `READY` or `PAUSED`.",
+							Computed:            true,
+						},
+						"environment_tag": schema.StringAttribute{
+							MarkdownDescription: "Environment tag of the service.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "Tags of the service.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "ServicesDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Client Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "ServicesDataSource.Read")
+
+	var state servicesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var filter map[string]string
+	if !state.Tags.IsNull() {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &filter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	services, err := d.client.GetAllServices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list services, got error: %s", err))
+		return
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	state.ID = types.StringValue("placeholder")
+	state.Services = []servicesDataSourceEntry{}
+	for _, s := range services {
+		tags := map[string]string{}
+		environment := types.StringNull()
+		if s.Metadata != nil {
+			tags = s.Metadata.TagMap()
+			environment = types.StringValue(s.Metadata.Environment)
+		}
+		if !hasTags(tags, filter) {
+			continue
+		}
+		tagsValue, diags := types.MapValueFrom(ctx, types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+		state.Services = append(state.Services, servicesDataSourceEntry{
+			ID:             types.StringValue(s.ID),
+			Name:           types.StringValue(s.Name),
+			RegionCode:     types.StringValue(s.RegionCode),
+			Status:         types.StringValue(s.Status),
+			EnvironmentTag: environment,
+			Tags:           tagsValue,
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// hasTags reports whether tags contains every entry of filter.
+func hasTags(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
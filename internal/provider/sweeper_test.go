@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestMain(m *testing.M) {
@@ -22,6 +23,35 @@ func init() {
 		Name: "timescale_vpcs",
 		F:    sweepVPCs,
 	})
+	resource.AddTestSweepers("timescale_service", &resource.Sweeper{
+		Name: "timescale_service",
+		F:    sweepExpiredServices,
+	})
+}
+
+// sweepExpiredServices deletes the services past their expires_at tag, such
+// as the ones left behind by tests setting a ttl.
+func sweepExpiredServices(_ string) error {
+	c, err := createSweepClient()
+	if err != nil {
+		return fmt.Errorf("error creating client: %s", err)
+	}
+
+	ctx := context.Background()
+
+	services, err := c.GetAllServices(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving services: %s", err)
+	}
+
+	for _, s := range expiredServices(services, time.Now(), nil) {
+		log.Printf("Destroying expired service %s (%s)", s.Name, s.ID)
+		if _, err := c.DeleteService(ctx, s.ID); err != nil {
+			log.Printf("Error deleting service %s (%s): %s", s.Name, s.ID, err)
+		}
+	}
+
+	return nil
 }
 
 // sweepVPCs finds and deletes any VPCs with the test prefix.
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsValidators validates resource tags and the provider default_tags.
+var tagsValidators = []validator.Map{
+	mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+	mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256)),
+}
+
+// mergeTags returns the default tags overridden by the resource tags.
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	all := make(map[string]string, len(defaults)+len(tags))
+	maps.Copy(all, defaults)
+	maps.Copy(all, tags)
+	return all
+}
+
+// resourceTags derives the resource-level tags from all the tags found on the
+// API, leaving out the ones that come from the provider default_tags unless
+// they are also configured on the resource.
+func resourceTags(all, defaults map[string]string, configured types.Map) (types.Map, diag.Diagnostics) {
+	var tags map[string]string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		diags := configured.ElementsAs(context.Background(), &tags, false)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+	}
+	result := map[string]string{}
+	for k, v := range all {
+		if _, ok := tags[k]; !ok {
+			if d, isDefault := defaults[k]; isDefault && d == v {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	if len(result) == 0 && configured.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(context.Background(), types.StringType, result)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTags(t *testing.T) {
+	defaults := map[string]string{"team": "data", "owner": "platform"}
+	tags := map[string]string{"owner": "alice", "cost_center": "42"}
+	require.Equal(t,
+		map[string]string{"team": "data", "owner": "alice", "cost_center": "42"},
+		mergeTags(defaults, tags))
+	require.Empty(t, mergeTags(nil, nil))
+}
+
+func TestResourceTags(t *testing.T) {
+	defaults := map[string]string{"team": "data", "owner": "platform"}
+	all := map[string]string{"team": "data", "owner": "alice", "cost_center": "42"}
+	configured := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner":       types.StringValue("alice"),
+		"cost_center": types.StringValue("42"),
+	})
+
+	tags, diags := resourceTags(all, defaults, configured)
+	require.False(t, diags.HasError())
+	var got map[string]string
+	require.False(t, tags.ElementsAs(context.Background(), &got, false).HasError())
+	require.Equal(t, map[string]string{"owner": "alice", "cost_center": "42"}, got, "inherited default tags must not show up in tags")
+
+	tags, diags = resourceTags(map[string]string{"team": "data"}, defaults, types.MapNull(types.StringType))
+	require.False(t, diags.HasError())
+	require.True(t, tags.IsNull(), "only inherited tags must keep an unset tags attribute null")
+
+	tags, diags = resourceTags(map[string]string{"team": "other"}, defaults, types.MapNull(types.StringType))
+	require.False(t, diags.HasError())
+	require.Len(t, tags.Elements(), 1, "a default tag changed outside Terraform must show up as drift")
+}
+
+func TestHasTags(t *testing.T) {
+	tags := map[string]string{"team": "data", "env": "prod"}
+	require.True(t, hasTags(tags, nil))
+	require.True(t, hasTags(tags, map[string]string{"team": "data"}))
+	require.False(t, hasTags(tags, map[string]string{"team": "web"}))
+	require.False(t, hasTags(tags, map[string]string{"owner": "alice"}))
+}
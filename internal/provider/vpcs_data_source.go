@@ -170,7 +170,9 @@ func (d *vpcsDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	d.client, _ = req.ProviderData.(*tsClient.Client)
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
 }
 
 // Schema defines the schema for the data source.